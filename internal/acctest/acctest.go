@@ -1401,6 +1401,16 @@ provider "aws" {
 `, key1)
 }
 
+// ConfigPlanTimeValidation enables plan-time validation using AWS service validation APIs.
+func ConfigPlanTimeValidation() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  plan_time_validation = true
+}
+`
+}
+
 // ConfigRegionalProvider creates a new provider configuration with a region.
 //
 // This can only be used for single provider configuration testing as it
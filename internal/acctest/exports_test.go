@@ -5,5 +5,9 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	AccountIDRedactor      = accountIDRedactor
+	CloseVCRRecorder       = closeVCRRecorder
+	RedactVCRInteraction   = redactVCRInteraction
+	RegisteredVCRRedactors = registeredVCRRedactors
+	VCRRequestMatcher      = vcrRequestMatcher
 )
@@ -7,22 +7,17 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -112,16 +107,25 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 	t.Helper()
 
 	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+	testName := t.Name()
 
 	for name := range input {
 		output[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+			primary, err := provider.New(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, testName)
+
+			// The Plugin Framework provider reads its instance state from the primary provider.
+			// Serve it the cached VCR-enabled state so that framework resources share the recording HTTP client.
+			providerServerFactory, err := provider.ProtoV5ProviderServerFactoryWithPrimary(ctx, primary, vcrPrimaryProvider{primary: primary, testName: testName})
+
+			if err != nil {
+				return nil, err
+			}
 
 			return providerServerFactory(), nil
 		}
@@ -130,6 +134,25 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 	return output
 }
 
+// vcrPrimaryProvider is the primary provider as seen by the Plugin Framework provider in VCR mode.
+type vcrPrimaryProvider struct {
+	primary  *schema.Provider
+	testName string
+}
+
+// Meta returns the cached VCR-enabled provider instance state for the test, if any.
+func (p vcrPrimaryProvider) Meta() interface{} {
+	providerMetas.Lock()
+	meta, ok := providerMetas[p.testName]
+	defer providerMetas.Unlock()
+
+	if ok {
+		return meta
+	}
+
+	return p.primary.Meta()
+}
+
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
@@ -183,77 +206,21 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+		// Scrub sensitive values from request and response bodies before the cassette is saved.
+		// The account ID is only known once the provider has been configured.
+		r.AddHook(func(i *cassette.Interaction) error {
+			redactors := registeredVCRRedactors()
+			if meta != nil && meta.AccountID != "" {
+				redactors = append(redactors, accountIDRedactor(meta.AccountID))
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
+			redactVCRInteraction(i, redactors)
 
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			return nil
+		}, recorder.BeforeSaveHook)
 
-			return false
-		})
+		// Defines how VCR will match requests to responses.
+		r.SetMatcher(vcrRequestMatcher(ctx))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
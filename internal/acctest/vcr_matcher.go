@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// vcrBodyMatcher reports whether a live request body and a recorded request body are equivalent.
+type vcrBodyMatcher func(ctx context.Context, body, cassetteBody string) bool
+
+// vcrBodyMatchers maps request media types to body matchers.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+var vcrBodyMatchers = map[string]vcrBodyMatcher{
+	// awsJson1_0, awsJson1_1 and restJson1 protocols.
+	"application/json":           jsonBodyMatcher,
+	"application/x-amz-json-1.0": jsonBodyMatcher,
+	"application/x-amz-json-1.1": jsonBodyMatcher,
+	// awsQuery and ec2Query protocols.
+	"application/x-www-form-urlencoded": queryBodyMatcher,
+	// restXml protocol.
+	"application/xml": xmlBodyMatcher,
+	"text/xml":        xmlBodyMatcher,
+}
+
+// vcrRequestMatcher returns a function that defines how VCR matches requests to recorded interactions.
+// Live requests are redacted in the same way as recorded interactions before comparison.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		redactors := registeredVCRRedactors()
+
+		if r.Method != i.Method {
+			return false
+		}
+
+		if redactVCRString(r.URL.String(), redactors) != redactVCRString(i.URL, redactors) {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redactVCRString(b.String(), redactors)
+
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+		if err != nil {
+			return false
+		}
+
+		if matcher, ok := vcrBodyMatchers[mediaType]; ok {
+			return matcher(ctx, body, i.Body)
+		}
+
+		return false
+	}
+}
+
+// jsonBodyMatcher matches JSON bodies whose object members may be reordered.
+func jsonBodyMatcher(ctx context.Context, body, cassetteBody string) bool {
+	var requestJSON, cassetteJSON interface{}
+
+	if err := json.Unmarshal([]byte(body), &requestJSON); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(cassetteBody), &cassetteJSON); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(requestJSON, cassetteJSON)
+}
+
+// queryBodyMatcher matches form-encoded bodies whose parameters may be reordered.
+func queryBodyMatcher(ctx context.Context, body, cassetteBody string) bool {
+	requestQuery, err := url.ParseQuery(body)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request query", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteQuery, err := url.ParseQuery(cassetteBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette query", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(requestQuery, cassetteQuery)
+}
+
+// xmlBodyMatcher matches XML bodies that differ only in insignificant whitespace, the XML declaration or attribute order.
+func xmlBodyMatcher(ctx context.Context, body, cassetteBody string) bool {
+	requestTokens, err := normalizeXML(body)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteTokens, err := normalizeXML(cassetteBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(requestTokens, cassetteTokens)
+}
+
+// normalizeXML returns the significant tokens of an XML document in a comparable form.
+func normalizeXML(s string) ([]string, error) {
+	var tokens []string
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			attrs := make(map[string]string, len(v.Attr))
+			for _, attr := range v.Attr {
+				attrs[attr.Name.Space+":"+attr.Name.Local] = attr.Value
+			}
+			b, _ := json.Marshal(attrs) // Map keys are sorted.
+			tokens = append(tokens, "<"+v.Name.Space+":"+v.Name.Local+string(b))
+		case xml.EndElement:
+			tokens = append(tokens, "</"+v.Name.Space+":"+v.Name.Local)
+		case xml.CharData:
+			if v := strings.TrimSpace(string(v)); v != "" {
+				tokens = append(tokens, v)
+			}
+		}
+	}
+
+	return tokens, nil
+}
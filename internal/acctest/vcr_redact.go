@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrRedactedAccountID replaces the recording account's ID in cassettes.
+	vcrRedactedAccountID = "123456789012"
+	// vcrRedactedValue replaces secret values in cassettes.
+	vcrRedactedValue = "REDACTED"
+)
+
+// VCRRedactor scrubs sensitive values from recorded HTTP interactions.
+// Redactors are applied to request URLs and to request and response bodies.
+// They must be deterministic and idempotent so that live requests can be matched
+// against previously redacted cassettes.
+type VCRRedactor interface {
+	Redact(string) string
+}
+
+// VCRRedactorFunc is an adapter allowing the use of ordinary functions as VCRRedactors.
+type VCRRedactorFunc func(string) string
+
+func (f VCRRedactorFunc) Redact(s string) string {
+	return f(s)
+}
+
+var (
+	vcrRedactorsMutex sync.Mutex
+	vcrRedactors      = []VCRRedactor{
+		VCRRedactorFunc(redactAccessKeyIDs),
+		VCRRedactorFunc(redactPresignedURLs),
+		VCRRedactorFunc(redactSecretValues),
+	}
+)
+
+// RegisterVCRRedactor adds a redactor that is applied to all VCR cassettes.
+// Service packages can use this to scrub values specific to their APIs.
+func RegisterVCRRedactor(r VCRRedactor) {
+	vcrRedactorsMutex.Lock()
+	defer vcrRedactorsMutex.Unlock()
+
+	vcrRedactors = append(vcrRedactors, r)
+}
+
+func registeredVCRRedactors() []VCRRedactor {
+	vcrRedactorsMutex.Lock()
+	defer vcrRedactorsMutex.Unlock()
+
+	return append([]VCRRedactor{}, vcrRedactors...)
+}
+
+func redactVCRString(s string, redactors []VCRRedactor) string {
+	for _, r := range redactors {
+		s = r.Redact(s)
+	}
+
+	return s
+}
+
+// redactVCRInteraction scrubs sensitive values from the specified interaction in place.
+func redactVCRInteraction(i *cassette.Interaction, redactors []VCRRedactor) {
+	i.Request.URL = redactVCRString(i.Request.URL, redactors)
+	i.Request.RequestURI = redactVCRString(i.Request.RequestURI, redactors)
+	i.Request.Body = redactVCRString(i.Request.Body, redactors)
+	for k, vs := range i.Request.Form {
+		for j, v := range vs {
+			i.Request.Form[k][j] = redactVCRString(v, redactors)
+		}
+	}
+	i.Request.ContentLength = int64(len(i.Request.Body))
+
+	i.Response.Body = redactVCRString(i.Response.Body, redactors)
+	if i.Response.ContentLength >= 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+	}
+}
+
+// accountIDRedactor returns a redactor that replaces the specified AWS account ID.
+func accountIDRedactor(accountID string) VCRRedactor {
+	return VCRRedactorFunc(func(s string) string {
+		return strings.ReplaceAll(s, accountID, vcrRedactedAccountID)
+	})
+}
+
+var accessKeyIDRegexp = regexache.MustCompile(`\b((?:AKIA|ASIA))[0-9A-Z]{16}\b`)
+
+// redactAccessKeyIDs replaces AWS access key IDs, preserving the key type prefix.
+func redactAccessKeyIDs(s string) string {
+	return accessKeyIDRegexp.ReplaceAllString(s, "${1}XXXXXXXXXXXXXXXX")
+}
+
+var presignedURLRegexp = regexache.MustCompile(`((?:X-Amz-Credential|X-Amz-Security-Token|X-Amz-Signature|Signature|AWSAccessKeyId)=)[^&"'<\s]+`)
+
+// redactPresignedURLs replaces the credentials and signatures embedded in presigned URLs.
+func redactPresignedURLs(s string) string {
+	return presignedURLRegexp.ReplaceAllString(s, "${1}"+vcrRedactedValue)
+}
+
+// vcrSecretFields are API field names whose values are always secret.
+const vcrSecretFields = `SecretAccessKey|SessionToken|SecretString|SecretBinary|Password|MasterUserPassword|AuthToken|PrivateKey|ClientSecret`
+
+var (
+	secretJSONRegexp  = regexache.MustCompile(`("(?:` + vcrSecretFields + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	secretXMLRegexp   = regexache.MustCompile(`(<(` + vcrSecretFields + `)>)[^<]*(</(?:` + vcrSecretFields + `)>)`)
+	secretQueryRegexp = regexache.MustCompile(`((?:^|&)(?:[\w.]+\.)?(?:` + vcrSecretFields + `)=)[^&]*`)
+)
+
+// redactSecretValues replaces the values of well-known secret fields in JSON, XML and query protocol bodies.
+func redactSecretValues(s string) string {
+	s = secretJSONRegexp.ReplaceAllString(s, `${1}"`+vcrRedactedValue+`"`)
+	s = secretXMLRegexp.ReplaceAllString(s, "${1}"+vcrRedactedValue+"${3}")
+	s = secretQueryRegexp.ReplaceAllString(s, "${1}"+vcrRedactedValue)
+
+	return s
+}
@@ -25,17 +25,22 @@ import (
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
+	AccountID           string
+	DefaultTagsConfig   *tftags.DefaultConfig
+	IgnoreTagsConfig    *tftags.IgnoreConfig
+	Partition           string
+	PlanPolicies        planpolicy.Policies
+	PlanTimeValidation  bool
+	PreventDestroyRules preventdestroy.Rules
+	Region              string
+	ServicePackages     map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
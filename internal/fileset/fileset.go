@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fileset selects local files using shell patterns.
+// Patterns have the same syntax as those of Terraform's fileset function.
+package fileset
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Match reports whether the slash-separated name matches the pattern.
+// As well as the path.Match syntax, a "**" path segment matches zero or more path segments.
+func Match(pattern, name string) (bool, error) {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(patterns, names []string) (bool, error) {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			patterns = patterns[1:]
+
+			if len(patterns) == 0 {
+				return true, nil
+			}
+
+			for i := 0; i <= len(names); i++ {
+				if ok, err := matchSegments(patterns, names[i:]); err != nil || ok {
+					return ok, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(names) == 0 {
+			return false, nil
+		}
+
+		if ok, err := path.Match(patterns[0], names[0]); err != nil || !ok {
+			return false, err
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0, nil
+}
+
+// ValidatePattern returns an error if the pattern is malformed.
+func ValidatePattern(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidatePatternFunc returns a Plugin SDK schema validation function that returns an error
+// if a string attribute's value is a malformed pattern.
+func ValidatePatternFunc() func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if err := ValidatePattern(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid pattern (%s): %w", k, value, err))
+		}
+
+		return
+	}
+}
+
+// Files returns the slash-separated paths, relative to root, of the regular files under root
+// that match any of the include patterns, or all files if there are none, and none of the exclude patterns.
+// Symbolic links to files are followed. Paths are returned in lexical order.
+func Files(root string, include, exclude []string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(root, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		ok, err := matchFile(relativePath, include, exclude)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			files = append(files, relativePath)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func matchFile(relativePath string, include, exclude []string) (bool, error) {
+	included := len(include) == 0
+
+	for _, pattern := range include {
+		ok, err := Match(pattern, relativePath)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			included = true
+			break
+		}
+	}
+
+	if !included {
+		return false, nil
+	}
+
+	for _, pattern := range exclude {
+		ok, err := Match(pattern, relativePath)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
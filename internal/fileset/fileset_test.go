@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fileset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "blog/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "blog/2024/index.html", true},
+		{"blog/**", "blog/2024/index.html", true},
+		{"blog/**", "docs/index.html", false},
+		{"assets/**/*.js", "assets/app.js", true},
+		{"assets/**/*.js", "assets/vendor/lib.js", true},
+		{"assets/**/*.js", "assets/vendor/lib.css", false},
+		{"**", "a/b/c", true},
+		{"a/?.txt", "a/b.txt", true},
+		{"a/[bc].txt", "a/d.txt", false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := Match(testCase.pattern, testCase.name)
+
+		if err != nil {
+			t.Fatalf("Match(%q, %q): unexpected error: %s", testCase.pattern, testCase.name, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("Match(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"*.html", "**/*.js", "a/[bc]/**"} {
+		if err := ValidatePattern(pattern); err != nil {
+			t.Errorf("ValidatePattern(%q): unexpected error: %s", pattern, err)
+		}
+	}
+
+	for _, pattern := range []string{"[", "a/[b/c", `a\`} {
+		if err := ValidatePattern(pattern); err == nil {
+			t.Errorf("ValidatePattern(%q): expected error", pattern)
+		}
+	}
+}
+
+func TestValidatePatternFunc(t *testing.T) {
+	t.Parallel()
+
+	f := ValidatePatternFunc()
+
+	if _, errs := f("**/*.js", "include"); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	if _, errs := f("a/[b/c", "include"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+
+	if _, errs := f(1, "include"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	for _, name := range []string{"index.html", "assets/app.js", "assets/app.js.map", "assets/vendor/lib.js", ".git/config"} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		"all": {
+			expected: []string{".git/config", "assets/app.js", "assets/app.js.map", "assets/vendor/lib.js", "index.html"},
+		},
+		"include": {
+			include:  []string{"**/*.js"},
+			expected: []string{"assets/app.js", "assets/vendor/lib.js"},
+		},
+		"exclude": {
+			exclude:  []string{".git/**", "**/*.map"},
+			expected: []string{"assets/app.js", "assets/vendor/lib.js", "index.html"},
+		},
+		"include and exclude": {
+			include:  []string{"assets/**"},
+			exclude:  []string{"assets/vendor/**"},
+			expected: []string{"assets/app.js", "assets/app.js.map"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Files(root, testCase.include, testCase.exclude)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ExpanderFunc expands a Plugin Framework value into an AWS API value of type T.
+// It is only called for values that are neither null nor unknown.
+type ExpanderFunc[T any] func(context.Context, attr.Value) (T, diag.Diagnostics)
+
+// FlattenerFunc flattens an AWS API value of type T into a Plugin Framework value of the specified type.
+// It is called for all values, including nil pointers, and must return a null value where appropriate.
+type FlattenerFunc[T any] func(context.Context, T, attr.Type) (attr.Value, diag.Diagnostics)
+
+// customConverterFunc converts `vFrom` to `vTo` using a custom expander or flattener.
+type customConverterFunc func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics
+
+var customConverters = struct {
+	sync.RWMutex
+	expanders  map[reflect.Type]customConverterFunc
+	flatteners map[reflect.Type]customConverterFunc
+}{
+	expanders:  make(map[reflect.Type]customConverterFunc),
+	flatteners: make(map[reflect.Type]customConverterFunc),
+}
+
+// RegisterExpander registers a custom expander for all AWS API values of type T.
+// It is typically called from a service package's init function.
+func RegisterExpander[T any](f ExpanderFunc[T]) {
+	customConverters.Lock()
+	defer customConverters.Unlock()
+
+	customConverters.expanders[typeOf[T]()] = newCustomExpander(f)
+}
+
+// RegisterFlattener registers a custom flattener for all AWS API values of type T.
+// It is typically called from a service package's init function.
+func RegisterFlattener[T any](f FlattenerFunc[T]) {
+	customConverters.Lock()
+	defer customConverters.Unlock()
+
+	customConverters.flatteners[typeOf[T]()] = newCustomFlattener(f)
+}
+
+// WithExpander is an AutoFlexOptionsFunc that uses a custom expander for AWS API values of type T.
+// It takes precedence over any expander registered via RegisterExpander.
+func WithExpander[T any](f ExpanderFunc[T]) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.expanders == nil {
+			o.expanders = make(map[reflect.Type]customConverterFunc)
+		}
+		o.expanders[typeOf[T]()] = newCustomExpander(f)
+	}
+}
+
+// WithFlattener is an AutoFlexOptionsFunc that uses a custom flattener for AWS API values of type T.
+// It takes precedence over any flattener registered via RegisterFlattener.
+func WithFlattener[T any](f FlattenerFunc[T]) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.flatteners == nil {
+			o.flatteners = make(map[reflect.Type]customConverterFunc)
+		}
+		o.flatteners[typeOf[T]()] = newCustomFlattener(f)
+	}
+}
+
+// customExpander returns any custom expander for the specified AWS API type.
+func (o *AutoFlexOptions) customExpander(t reflect.Type) (customConverterFunc, bool) {
+	if f, ok := o.expanders[t]; ok {
+		return f, true
+	}
+
+	customConverters.RLock()
+	defer customConverters.RUnlock()
+
+	f, ok := customConverters.expanders[t]
+	return f, ok
+}
+
+// customFlattener returns any custom flattener for the specified AWS API type.
+func (o *AutoFlexOptions) customFlattener(t reflect.Type) (customConverterFunc, bool) {
+	if f, ok := o.flatteners[t]; ok {
+		return f, true
+	}
+
+	customConverters.RLock()
+	defer customConverters.RUnlock()
+
+	f, ok := customConverters.flatteners[t]
+	return f, ok
+}
+
+func newCustomExpander[T any](f ExpanderFunc[T]) customConverterFunc {
+	return func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		from, ok := vFrom.Interface().(attr.Value)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vFrom.Kind()))
+			return diags
+		}
+
+		to, d := f(ctx, from)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Go via a pointer so that interface types are preserved.
+		vTo.Set(reflect.ValueOf(&to).Elem())
+
+		return diags
+	}
+}
+
+func newCustomFlattener[T any](f FlattenerFunc[T]) customConverterFunc {
+	return func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		valTo, ok := vTo.Interface().(attr.Value)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+			return diags
+		}
+
+		from, _ := vFrom.Interface().(T) // nil interface values flatten from the zero value.
+		to, d := f(ctx, from, valTo.Type(ctx))
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("custom flattener for %s returned nil", vFrom.Type()))
+			return diags
+		}
+
+		if v := reflect.ValueOf(to); v.Type().AssignableTo(vTo.Type()) {
+			vTo.Set(v)
+		} else {
+			diags.AddError("AutoFlEx", fmt.Sprintf("custom flattener for %s returned %T, want %s", vFrom.Type(), to, vTo.Type()))
+		}
+
+		return diags
+	}
+}
+
+// typeOf returns the reflect.Type of T, including interface types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
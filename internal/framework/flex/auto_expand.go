@@ -77,6 +77,11 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 		return diags
 	}
 
+	if f, ok := expander.Options.customExpander(vTo.Type()); ok {
+		diags.Append(f(ctx, valFrom, vTo)...)
+		return diags
+	}
+
 	switch vFrom := vFrom.(type) {
 	// Primitive types.
 	case basetypes.BoolValuable:
@@ -358,6 +363,17 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if members, ok := unionMembers(tTo); ok {
+			if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+				diags.Append(expander.nestedObjectToUnion(ctx, vFrom, members, vTo)...)
+				return diags
+			}
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -723,17 +739,27 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			if members, ok := unionMembers(tElem); ok {
+				diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, members, vTo)...)
+				return diags
+			}
+
+			// Unregistered union type. Silently skip.
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		if members, ok := unionMembers(tTo); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, members, vTo)...)
+			return diags
+		}
+
+		// Unregistered union type. Silently skip.
 		return diags
 	}
 
@@ -5,6 +5,7 @@ package flex
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -1180,6 +1182,143 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	RegisterUnion[TestFlexUnionAWS](&TestFlexUnionAWSMemberStringValue{}, &TestFlexUnionAWSMemberNested{})
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "scalar member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringValue("a"),
+					Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberStringValue{Value: "a"}},
+		},
+		{
+			TestName: "nested member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringNull(),
+					Nested:      fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+				}),
+			},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "a"}}},
+		},
+		{
+			TestName: "no member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringNull(),
+					Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "empty block",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust[TestFlexUnionTF](ctx, []TestFlexUnionTF{}),
+			},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "multiple members",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringValue("a"),
+					Nested:      fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+				}),
+			},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "slice of union",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexUnionTF{
+					{
+						StringValue: types.StringValue("a"),
+						Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						Nested:      fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+					},
+				}),
+			},
+			Target: &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberStringValue{Value: "a"},
+				&TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandCustomExpander(t *testing.T) {
+	t.Parallel()
+
+	expandCustom := func(ctx context.Context, v attr.Value) (TestFlexCustomAWS, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		a, b, _ := strings.Cut(v.(types.String).ValueString(), ":")
+
+		return TestFlexCustomAWS{A: a, B: b}, diags
+	}
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "no custom expander",
+			Source:     &TestFlexTF01{Field1: types.StringValue("a:b")},
+			Target:     &TestFlexCustomAWS01{},
+			WantTarget: &TestFlexCustomAWS01{},
+		},
+		{
+			TestName: "custom expander",
+			Options: []AutoFlexOptionsFunc{
+				WithExpander(expandCustom),
+			},
+			Source:     &TestFlexTF01{Field1: types.StringValue("a:b")},
+			Target:     &TestFlexCustomAWS01{},
+			WantTarget: &TestFlexCustomAWS01{Field1: TestFlexCustomAWS{A: "a", B: "b"}},
+		},
+		{
+			TestName: "custom expander null source",
+			Options: []AutoFlexOptionsFunc{
+				WithExpander(expandCustom),
+			},
+			Source:     &TestFlexTF01{Field1: types.StringNull()},
+			Target:     &TestFlexCustomAWS01{},
+			WantTarget: &TestFlexCustomAWS01{},
+		},
+		{
+			TestName: "custom expander error",
+			Options: []AutoFlexOptionsFunc{
+				WithExpander(func(context.Context, attr.Value) (TestFlexCustomAWS, diag.Diagnostics) {
+					var diags diag.Diagnostics
+					diags.AddError("test", "error")
+					return TestFlexCustomAWS{}, diags
+				}),
+			},
+			Source:  &TestFlexTF01{Field1: types.StringValue("a:b")},
+			Target:  &TestFlexCustomAWS01{},
+			WantErr: true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	Options    []AutoFlexOptionsFunc
@@ -77,6 +77,14 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	}
 
 	tTo := valTo.Type(ctx)
+
+	if vFrom.IsValid() {
+		if f, ok := flattener.Options.customFlattener(vFrom.Type()); ok {
+			diags.Append(f(ctx, vFrom, vTo)...)
+			return diags
+		}
+	}
+
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
 		diags.Append(flattener.bool(ctx, vFrom, false, tTo, vTo)...)
@@ -332,6 +340,15 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
+	case fwtypes.NestedObjectType:
+		if members, ok := unionMembers(vFrom.Type()); ok {
+			//
+			// union -> types.List(OfObject) or types.Object.
+			//
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, members, tTo, vTo)...)
+			return diags
+		}
+
 	case basetypes.StringTypable:
 		stringValue := types.StringNull()
 		if !isNullFrom {
@@ -505,7 +522,17 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			if members, ok := unionMembers(tSliceElem); ok {
+				//
+				// []union -> types.List(OfObject).
+				//
+				diags.Append(flattener.sliceOfUnionToNestedObjectCollection(ctx, vFrom, members, tTo, vTo)...)
+				return diags
+			}
+		}
+
+		// Unregistered union type. Silently skip.
 		return diags
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -1153,6 +1154,118 @@ func TestFlattenOptions(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	RegisterUnion[TestFlexUnionAWS](&TestFlexUnionAWSMemberStringValue{}, &TestFlexUnionAWSMemberNested{})
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "scalar member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberStringValue{Value: "a"}},
+			Target:   &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringValue("a"),
+					Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+		},
+		{
+			TestName: "nested member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "a"}}},
+			Target:   &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringNull(),
+					Nested:      fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+				}),
+			},
+		},
+		{
+			TestName:   "nil union",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF](ctx)},
+		},
+		{
+			TestName: "unknown member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionAWSMemberUnknown{Value: "a"}},
+			Target:   &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF{
+					StringValue: types.StringNull(),
+					Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+		},
+		{
+			TestName: "slice of union",
+			Source: &TestFlexUnionAWS02{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberStringValue{Value: "a"},
+				&TestFlexUnionAWSMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+			Target: &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexUnionTF{
+					{
+						StringValue: types.StringValue("a"),
+						Nested:      fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						Nested:      fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+					},
+				}),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenCustomFlattener(t *testing.T) {
+	t.Parallel()
+
+	flattenCustom := func(ctx context.Context, v TestFlexCustomAWS, _ attr.Type) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		return types.StringValue(v.A + ":" + v.B), diags
+	}
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "no custom flattener",
+			Source:     &TestFlexCustomAWS01{Field1: TestFlexCustomAWS{A: "a", B: "b"}},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{},
+		},
+		{
+			TestName: "custom flattener",
+			Options: []AutoFlexOptionsFunc{
+				WithFlattener(flattenCustom),
+			},
+			Source:     &TestFlexCustomAWS01{Field1: TestFlexCustomAWS{A: "a", B: "b"}},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringValue("a:b")},
+		},
+		{
+			TestName: "custom flattener incompatible result",
+			Options: []AutoFlexOptionsFunc{
+				WithFlattener(func(context.Context, TestFlexCustomAWS, attr.Type) (attr.Value, diag.Diagnostics) {
+					var diags diag.Diagnostics
+					return types.Int64Value(1), diags
+				}),
+			},
+			Source:  &TestFlexCustomAWS01{Field1: TestFlexCustomAWS{A: "a", B: "b"}},
+			Target:  &TestFlexTF01{},
+			WantErr: true,
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
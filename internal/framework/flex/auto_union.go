@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// AWS SDK for Go v2 represents Smithy union types as an interface (e.g. `types.Action`)
+// implemented by one pointer-to-struct type per union member (e.g. `*types.ActionMemberFoo`),
+// each of which holds the member's value in a field named `Value`.
+//
+// A union is modeled in Terraform as a nested block (a List or Object of a struct) that has one
+// field per union member, named after the member (e.g. `Foo`). Exactly one of the fields may be set.
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMember describes a single member of a union.
+type unionMember struct {
+	// name is the member name, e.g. `Foo` for `*types.ActionMemberFoo`.
+	name string
+	// typ is the member's pointer-to-struct type.
+	typ reflect.Type
+}
+
+var unions = struct {
+	sync.RWMutex
+	members map[reflect.Type][]unionMember
+}{
+	members: make(map[reflect.Type][]unionMember),
+}
+
+// RegisterUnion registers the members of the union interface type T.
+// Go reflection can't enumerate the implementations of an interface, so each member must be specified, e.g.
+//
+//	flex.RegisterUnion[awstypes.Action](&awstypes.ActionMemberFoo{}, &awstypes.ActionMemberBar{})
+//
+// RegisterUnion panics if a member isn't a pointer to a struct with a `Value` field.
+func RegisterUnion[T any](members ...T) {
+	tUnion := typeOf[T]()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("AutoFlEx: union type %s is not an interface", tUnion))
+	}
+
+	var unionMembers []unionMember
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Ptr || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("AutoFlEx: union member %T of %s is not a pointer to struct", member, tUnion))
+		}
+		if _, ok := tMember.Elem().FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("AutoFlEx: union member %T of %s has no %s field", member, tUnion, unionMemberValueFieldName))
+		}
+
+		unionMembers = append(unionMembers, unionMember{
+			name: unionMemberName(tUnion, tMember),
+			typ:  tMember,
+		})
+	}
+
+	unions.Lock()
+	defer unions.Unlock()
+
+	unions.members[tUnion] = unionMembers
+}
+
+// unionMembers returns the registered members of the specified union interface type.
+func unionMembers(t reflect.Type) ([]unionMember, bool) {
+	unions.RLock()
+	defer unions.RUnlock()
+
+	members, ok := unions.members[t]
+	return members, ok
+}
+
+// unionMemberName returns the member name of a union member type.
+// For example `*types.ActionMemberFoo` implementing `types.Action` has member name `Foo`.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	name := tMember.Elem().Name()
+
+	if v := tUnion.Name() + "Member"; strings.HasPrefix(name, v) {
+		return strings.TrimPrefix(name, v)
+	}
+	if _, v, ok := strings.Cut(name, "Member"); ok {
+		return v
+	}
+
+	return name
+}
+
+// unionMemberField returns the field of the Terraform union struct corresponding to the named member.
+func unionMemberField(valStruct reflect.Value, name string) reflect.Value {
+	if v := valStruct.FieldByName(name); v.IsValid() {
+		return v
+	}
+
+	for i, typ := 0, valStruct.Type(); i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.PkgPath == "" && strings.EqualFold(field.Name, name) {
+			return valStruct.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, members []unionMember, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	to, d := expander.unionMember(ctx, from, members)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, members []unionMember, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(vTo.Type(), 0, n)
+	for i := 0; i < n; i++ {
+		to, d := expander.unionMember(ctx, f.Index(i).Interface(), members)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.IsValid() {
+			t = reflect.Append(t, to)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// unionMember expands the Terraform union struct pointer `from` into a new union member value.
+// An invalid value is returned if no member is set.
+func (expander autoExpander) unionMember(ctx context.Context, from any, members []unionMember) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	valFrom := reflect.ValueOf(from)
+	if !valFrom.IsValid() || valFrom.IsNil() {
+		return reflect.Value{}, diags
+	}
+	valFrom = valFrom.Elem()
+
+	var to reflect.Value
+	var name string
+	for _, member := range members {
+		fieldVal := unionMemberField(valFrom, member.name)
+		if !fieldVal.IsValid() {
+			continue
+		}
+
+		if v, ok := fieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if to.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union %T: more than one member set (%s, %s)", from, name, member.name))
+			return reflect.Value{}, diags
+		}
+
+		to, name = reflect.New(member.typ.Elem()), member.name
+		diags.Append(expander.convert(ctx, fieldVal, to.Elem().FieldByName(unionMemberValueFieldName))...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", member.name))
+			return reflect.Value{}, diags
+		}
+	}
+
+	return to, diags
+}
+
+// unionToNestedObject copies an AWS API union value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, members []unionMember, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.unionMember(ctx, vFrom, members, to)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionToNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionToNestedObjectCollection(ctx context.Context, vFrom reflect.Value, members []unionMember, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(flattener.unionMember(ctx, vFrom.Index(i), members, target)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionMember flattens the AWS API union value `vFrom` into the Terraform union struct pointer `to`.
+// All fields other than the one corresponding to the set member are null.
+func (flattener autoFlattener) unionMember(ctx context.Context, vFrom reflect.Value, members []unionMember, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to).Elem()
+	diags.Append(nullOutStructFields(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if vFrom.Kind() == reflect.Interface {
+		vFrom = vFrom.Elem()
+	}
+	if !vFrom.IsValid() || vFrom.IsNil() {
+		return diags
+	}
+
+	for _, member := range members {
+		if vFrom.Type() != member.typ {
+			continue
+		}
+
+		fieldVal := unionMemberField(valTo, member.name)
+		if !fieldVal.IsValid() || !fieldVal.CanSet() {
+			tflog.Info(ctx, "AutoFlex Flatten; union member not modeled", map[string]interface{}{
+				"from": member.typ,
+				"to":   valTo.Type(),
+			})
+			return diags
+		}
+
+		diags.Append(flattener.convert(ctx, vFrom.Elem().FieldByName(unionMemberValueFieldName), fieldVal)...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", member.name))
+			return diags
+		}
+
+		return diags
+	}
+
+	// Typically *types.UnknownUnionMember, returned for members added to the API after the SDK was generated.
+	tflog.Info(ctx, "AutoFlex Flatten; unknown union member", map[string]interface{}{
+		"from": vFrom.Type(),
+		"to":   valTo.Type(),
+	})
+
+	return diags
+}
+
+// nullOutStructFields sets all applicable fields of the specified struct value to their null values.
+func nullOutStructFields(ctx context.Context, valStruct reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := 0; i < valStruct.NumField(); i++ {
+		val := valStruct.Field(i)
+		if !val.CanInterface() || !val.CanSet() {
+			continue
+		}
+
+		attrValue, err := fwtypes.NullValueOf(ctx, val.Interface())
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+
+		if attrValue == nil {
+			continue
+		}
+
+		val.Set(reflect.ValueOf(attrValue))
+	}
+
+	return diags
+}
@@ -36,6 +36,11 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// expanders and flatteners store custom conversion functions keyed by
+	// AWS API type. They take precedence over globally registered functions.
+	expanders  map[reflect.Type]customConverterFunc
+	flatteners map[reflect.Type]customConverterFunc
 }
 
 // IsIgnoredField returns true if s is in the list of ignored field names
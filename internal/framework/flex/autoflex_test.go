@@ -361,3 +361,51 @@ type TestFlexAWS21 struct {
 type TestFlexAWS22 struct {
 	Field1 map[string]map[string]*string
 }
+
+type TestFlexUnionAWS interface {
+	isTestFlexUnionAWS()
+}
+
+type TestFlexUnionAWSMemberStringValue struct {
+	Value string
+}
+
+func (*TestFlexUnionAWSMemberStringValue) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberNested struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionAWSMemberNested) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberUnknown struct {
+	Value string
+}
+
+func (*TestFlexUnionAWSMemberUnknown) isTestFlexUnionAWS() {}
+
+type TestFlexUnionTF struct {
+	StringValue types.String                                  `tfsdk:"string_value"`
+	Nested      fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"nested"`
+}
+
+type TestFlexUnionAWS01 struct {
+	Field1 TestFlexUnionAWS
+}
+
+type TestFlexUnionTF01 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF] `tfsdk:"field1"`
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 []TestFlexUnionAWS
+}
+
+type TestFlexCustomAWS struct {
+	A string
+	B string
+}
+
+type TestFlexCustomAWS01 struct {
+	Field1 TestFlexCustomAWS
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+const (
+	// The Service Authorization Reference in machine-readable form.
+	// See https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html.
+	serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+)
+
+// serviceReferenceIndexEntry is an entry in the list of services.
+type serviceReferenceIndexEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+// serviceReference is a single service's actions and resource types.
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	Resources []struct {
+		Name       string   `json:"Name"`
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+func main() {
+	const (
+		filename = `internal/iampolicy/actions.json`
+	)
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	g.Infof("Generating %s", filename)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	var index []serviceReferenceIndexEntry
+	if err := getJSON(ctx, client, serviceReferenceURL, &index); err != nil {
+		g.Fatalf("reading service list: %s", err)
+	}
+
+	catalog := iampolicy.Catalog{
+		Complete: true,
+		Services: make(map[string]*iampolicy.Service, len(index)),
+	}
+
+	for _, entry := range index {
+		var ref serviceReference
+		if err := getJSON(ctx, client, entry.URL, &ref); err != nil {
+			g.Fatalf("reading service (%s): %s", entry.Service, err)
+		}
+
+		prefix := strings.ToLower(entry.Service)
+		service := &iampolicy.Service{
+			Actions: []string{},
+		}
+
+		for _, action := range ref.Actions {
+			service.Actions = append(service.Actions, action.Name)
+		}
+		slices.Sort(service.Actions)
+		service.Actions = slices.Compact(service.Actions)
+
+		for _, resource := range ref.Resources {
+			for _, arn := range resource.ARNFormats {
+				// e.g. "arn:${Partition}:iam::${Account}:role/${RoleNameWithPath}".
+				if parts := strings.SplitN(arn, ":", 4); len(parts) == 4 && parts[2] != "" {
+					service.ResourceServices = append(service.ResourceServices, strings.ToLower(parts[2]))
+				}
+			}
+		}
+		slices.Sort(service.ResourceServices)
+		service.ResourceServices = slices.Compact(service.ResourceServices)
+		if slices.Equal(service.ResourceServices, []string{prefix}) || len(service.ResourceServices) == 0 {
+			service.ResourceServices = nil
+		}
+
+		catalog.Services[prefix] = service
+	}
+
+	body, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding catalog: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.WriteBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Finding is a difference between a schema and its documentation.
+type Finding struct {
+	// Line is the documentation line number, 0 if not applicable.
+	Line    int
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Line > 0 {
+		return fmt.Sprintf("%d: %s", f.Line, f.Message)
+	}
+
+	return f.Message
+}
+
+var (
+	// ignoredNames are never reported as undocumented or stale.
+	ignoredNames = map[string]bool{
+		"id": true, // Implicit in Plugin SDK v2 schemas and often described in prose.
+	}
+
+	// undocumentedBlocks are documented in their own sections, e.g. Timeouts.
+	undocumentedBlocks = map[string]bool{
+		"timeouts": true,
+	}
+)
+
+// Check compares an entity's schema with its documentation.
+// It reports
+//   - attributes, at any level of nesting, that aren't documented
+//   - documented attributes that aren't in the schema
+//   - top-level arguments documented as (Required) or (Optional) contrary to the schema
+//   - computed-only top-level attributes documented as arguments
+//
+// Nested attributes are matched by name only, as documentation pages don't consistently indicate nesting.
+// Deprecated attributes may be left undocumented.
+func Check(e *Entity, doc *Document) []Finding {
+	var findings []Finding
+
+	documented := doc.Names()
+	inSchema := make(map[string]bool)
+
+	e.Walk(func(path []string, attr *Attribute) bool {
+		if len(path) == 1 && undocumentedBlocks[attr.Name] {
+			return false
+		}
+
+		inSchema[attr.Name] = true
+
+		if !documented[attr.Name] && !ignoredNames[attr.Name] && !attr.Deprecated {
+			findings = append(findings, Finding{
+				Message: fmt.Sprintf("%s: undocumented attribute %q", e.TypeName, strings.Join(path, ".")),
+			})
+		}
+
+		return true
+	})
+
+	for _, item := range doc.Items {
+		if !inSchema[item.Name] && !ignoredNames[item.Name] && !undocumentedBlocks[item.Name] {
+			findings = append(findings, Finding{
+				Line:    item.Line,
+				Message: fmt.Sprintf("%s: documented attribute %q is not in the schema", e.TypeName, item.Name),
+			})
+		}
+	}
+
+	for _, attr := range e.Attributes {
+		item, ok := doc.Item(attr.Name, SectionArguments)
+		if !ok {
+			continue
+		}
+
+		switch documentedAs := argumentKind(item.Text); {
+		case documentedAs == "":
+		case !attr.IsArgument():
+			findings = append(findings, Finding{
+				Line:    item.Line,
+				Message: fmt.Sprintf("%s: %q is documented as (%s) but is computed-only", e.TypeName, attr.Name, documentedAs),
+			})
+		case attr.Required && documentedAs != "Required":
+			findings = append(findings, Finding{
+				Line:    item.Line,
+				Message: fmt.Sprintf("%s: %q is documented as (%s) but is Required", e.TypeName, attr.Name, documentedAs),
+			})
+		case attr.Optional && documentedAs != "Optional":
+			findings = append(findings, Finding{
+				Line:    item.Line,
+				Message: fmt.Sprintf("%s: %q is documented as (%s) but is Optional", e.TypeName, attr.Name, documentedAs),
+			})
+		}
+	}
+
+	return findings
+}
+
+// argumentKind returns "Required" or "Optional" if a documented item's text starts with "(Required)" or "(Optional)".
+func argumentKind(text string) string {
+	for _, v := range []string{"Required", "Optional"} {
+		if strings.HasPrefix(text, "("+v+")") || strings.HasPrefix(text, "("+v+",") {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testSchema() *tfprotov5.Schema {
+	return &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "arn", Type: tftypes.String, Computed: true},
+				{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "name", Type: tftypes.String, Required: true, Description: "Name of the thing"},
+				{Name: "old", Type: tftypes.String, Optional: true, Deprecated: true},
+				{Name: "status", Type: tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"code": tftypes.String,
+				}}}, Computed: true},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "config",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					MaxItems: 1,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "size", Type: tftypes.Number, Optional: true},
+						},
+					},
+				},
+				{
+					TypeName: "timeouts",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "create", Type: tftypes.String, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	entity := NewEntity(ctx, "aws_example_thing", false, testSchema(), nil)
+
+	testCases := map[string]struct {
+		document string
+		want     []string
+	}{
+		"in sync": {
+			document: testDocument,
+		},
+		"undocumented and stale": {
+			document: strings.NewReplacer(
+				"* `size` - (Optional) Size.\n", "* `sizes` - (Optional) Size.\n",
+				"* `status.0.code` - Status code.\n", "",
+			).Replace(testDocument),
+			want: []string{
+				`aws_example_thing: undocumented attribute "config.size"`,
+				`aws_example_thing: undocumented attribute "status"`,
+				`aws_example_thing: undocumented attribute "status.code"`,
+				`22: aws_example_thing: documented attribute "sizes" is not in the schema`,
+			},
+		},
+		"wrong argument kind": {
+			document: strings.NewReplacer(
+				"(Required) Name", "(Optional) Name",
+				"## Attribute Reference\n\n* `arn` - ARN of the thing.\n", "* `arn` - (Optional) ARN.\n\n## Attribute Reference\n\n",
+			).Replace(testDocument),
+			want: []string{
+				`24: aws_example_thing: "arn" is documented as (Optional) but is computed-only`,
+				`17: aws_example_thing: "name" is documented as (Optional) but is Required`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := ParseDocument(strings.NewReader(testCase.document))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, finding := range Check(entity, doc) {
+				got = append(got, finding.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Entity is a documentation-oriented view of a resource's or data source's schema.
+type Entity struct {
+	TypeName     string
+	IsDataSource bool
+	Attributes   []*Attribute
+}
+
+// Attribute is a documentation-oriented view of a schema attribute or nested block.
+type Attribute struct {
+	Name        string
+	Description string
+	Required    bool
+	Optional    bool
+	Computed    bool
+	Deprecated  bool
+	ForceNew    bool
+	Default     string
+	// IsBlock is true for nested configuration blocks.
+	IsBlock bool
+	// MaxItems is the maximum number of nested blocks, 0 for no limit.
+	MaxItems   int64
+	Attributes []*Attribute
+}
+
+// IsArgument returns whether the attribute can be configured.
+func (a *Attribute) IsArgument() bool {
+	return a.Required || a.Optional
+}
+
+// DetailsFunc returns the details of the attribute at the specified path that
+// are not available from the provider's protocol schema.
+type DetailsFunc func(ctx context.Context, path *tftypes.AttributePath) (forceNew bool, defaultValue string)
+
+// NewEntity returns an Entity for the specified protocol schema.
+// The optional DetailsFunc is used to determine ForceNew and default values.
+func NewEntity(ctx context.Context, typeName string, isDataSource bool, schema *tfprotov5.Schema, details DetailsFunc) *Entity {
+	entity := &Entity{
+		TypeName:     typeName,
+		IsDataSource: isDataSource,
+	}
+
+	if schema != nil {
+		entity.Attributes = newAttributes(ctx, schema.Block, tftypes.NewAttributePath(), details)
+	}
+
+	return entity
+}
+
+// Walk calls f for every attribute, depth first, with the attribute's path.
+// Nested attributes are not visited if f returns false.
+func (e *Entity) Walk(f func(path []string, attr *Attribute) bool) {
+	walkAttributes(nil, e.Attributes, f)
+}
+
+func walkAttributes(path []string, attrs []*Attribute, f func([]string, *Attribute) bool) {
+	for _, attr := range attrs {
+		path := append(slices.Clone(path), attr.Name)
+		if f(path, attr) {
+			walkAttributes(path, attr.Attributes, f)
+		}
+	}
+}
+
+func newAttributes(ctx context.Context, block *tfprotov5.SchemaBlock, path *tftypes.AttributePath, details DetailsFunc) []*Attribute {
+	var attrs []*Attribute
+
+	if block == nil {
+		return attrs
+	}
+
+	for _, v := range block.Attributes {
+		path := path.WithAttributeName(v.Name)
+		attr := &Attribute{
+			Name:        v.Name,
+			Description: v.Description,
+			Required:    v.Required,
+			Optional:    v.Optional,
+			Computed:    v.Computed,
+			Deprecated:  v.Deprecated,
+		}
+		if details != nil {
+			attr.ForceNew, attr.Default = details(ctx, path)
+		}
+		attr.Attributes = newObjectAttributes(v.Type)
+
+		attrs = append(attrs, attr)
+	}
+
+	for _, v := range block.BlockTypes {
+		path := path.WithAttributeName(v.TypeName)
+		attr := &Attribute{
+			Name:        v.TypeName,
+			Description: v.Block.Description,
+			Required:    v.MinItems > 0,
+			Optional:    v.MinItems == 0,
+			Deprecated:  v.Block.Deprecated,
+			IsBlock:     true,
+			MaxItems:    v.MaxItems,
+		}
+		if details != nil {
+			attr.ForceNew, _ = details(ctx, path)
+		}
+
+		switch v.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			path = path.WithElementKeyInt(0)
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			path = path.WithElementKeyValue(tftypes.NewValue(v.Block.ValueType(), nil))
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			path = path.WithElementKeyString("")
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			attr.MaxItems = 1
+		}
+		attr.Attributes = newAttributes(ctx, v.Block, path, details)
+
+		attrs = append(attrs, attr)
+	}
+
+	slices.SortFunc(attrs, func(a, b *Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return attrs
+}
+
+// newObjectAttributes returns computed attributes for the members of an object (or collection of objects) type.
+func newObjectAttributes(typ tftypes.Type) []*Attribute {
+	var attrs []*Attribute
+
+	switch v := typ.(type) {
+	case tftypes.List:
+		return newObjectAttributes(v.ElementType)
+	case tftypes.Set:
+		return newObjectAttributes(v.ElementType)
+	case tftypes.Map:
+		return newObjectAttributes(v.ElementType)
+	case tftypes.Object:
+		for name, typ := range v.AttributeTypes {
+			attrs = append(attrs, &Attribute{
+				Name:       name,
+				Computed:   true,
+				Attributes: newObjectAttributes(typ),
+			})
+		}
+	}
+
+	slices.SortFunc(attrs, func(a, b *Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return attrs
+}
+
+// SDKDetails returns a DetailsFunc for a Plugin SDK v2 resource.
+func SDKDetails(r *sdkschema.Resource) DetailsFunc {
+	return func(_ context.Context, path *tftypes.AttributePath) (bool, string) {
+		var s *sdkschema.Schema
+
+		m := r.SchemaMap()
+		for _, step := range path.Steps() {
+			name, ok := step.(tftypes.AttributeName)
+			if !ok {
+				continue
+			}
+
+			if m == nil {
+				return false, ""
+			}
+			if s, ok = m[string(name)]; !ok {
+				return false, ""
+			}
+
+			m = nil
+			if v, ok := s.Elem.(*sdkschema.Resource); ok {
+				m = v.SchemaMap()
+			}
+		}
+
+		if s == nil {
+			return false, ""
+		}
+
+		var defaultValue string
+		if s.Default != nil {
+			defaultValue = fmt.Sprintf("%v", s.Default)
+		}
+
+		return s.ForceNew, defaultValue
+	}
+}
+
+// FrameworkDetails returns a DetailsFunc for a Terraform Plugin Framework resource.
+func FrameworkDetails(s fwschema.Schema) DetailsFunc {
+	return func(ctx context.Context, path *tftypes.AttributePath) (bool, string) {
+		if v, err := s.AttributeAtTerraformPath(ctx, path); err == nil {
+			return requiresReplace(ctx, v), defaultValue(ctx, v)
+		}
+
+		// Blocks are not attributes, so walk the blocks.
+		var block fwschema.Block
+		blocks := s.Blocks
+		for _, step := range path.Steps() {
+			name, ok := step.(tftypes.AttributeName)
+			if !ok {
+				continue
+			}
+
+			if block, ok = blocks[string(name)]; !ok {
+				return false, ""
+			}
+			blocks = nestedBlocks(block)
+		}
+
+		if block == nil {
+			return false, ""
+		}
+
+		return requiresReplace(ctx, block), ""
+	}
+}
+
+func nestedBlocks(b fwschema.Block) map[string]fwschema.Block {
+	switch v := b.(type) {
+	case fwschema.ListNestedBlock:
+		return v.NestedObject.Blocks
+	case fwschema.SetNestedBlock:
+		return v.NestedObject.Blocks
+	case fwschema.SingleNestedBlock:
+		return v.Blocks
+	}
+
+	return nil
+}
+
+// descriptionDescriber is implemented by plan modifiers and defaults.
+type descriptionDescriber interface {
+	Description(context.Context) string
+}
+
+// requiresReplace returns whether any of the framework attribute's or block's plan modifiers requires replacement.
+// Plan modifiers are retrieved via the type-specific `<Type>PlanModifiers` methods.
+func requiresReplace(ctx context.Context, v any) bool {
+	for _, result := range callMethodsWithSuffix(v, "PlanModifiers") {
+		if result.Kind() != reflect.Slice {
+			continue
+		}
+
+		for i := 0; i < result.Len(); i++ {
+			if v, ok := result.Index(i).Interface().(descriptionDescriber); ok {
+				if strings.Contains(v.Description(ctx), "destroy and recreate the resource") {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// defaultValue returns a framework attribute's default value, as described by its default.
+// Defaults are retrieved via the type-specific `<Type>DefaultValue` methods.
+func defaultValue(ctx context.Context, v any) string {
+	for _, result := range callMethodsWithSuffix(v, "DefaultValue") {
+		if !result.IsValid() || (result.Kind() == reflect.Interface && result.IsNil()) {
+			continue
+		}
+
+		if v, ok := result.Interface().(descriptionDescriber); ok {
+			return strings.TrimPrefix(v.Description(ctx), "value defaults to ")
+		}
+	}
+
+	return ""
+}
+
+// callMethodsWithSuffix calls each of v's niladic methods whose name has the specified suffix and returns the first results.
+func callMethodsWithSuffix(v any, suffix string) []reflect.Value {
+	var results []reflect.Value
+
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return results
+	}
+
+	for i, typ := 0, val.Type(); i < typ.NumMethod(); i++ {
+		method := typ.Method(i)
+		if !strings.HasSuffix(method.Name, suffix) || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		results = append(results, val.Method(i).Call(nil)[0])
+	}
+
+	return results
+}
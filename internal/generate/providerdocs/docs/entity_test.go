@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"context"
+	"testing"
+
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSDKDetails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	details := SDKDetails(&sdkschema.Resource{
+		Schema: map[string]*sdkschema.Schema{
+			"name": {
+				Type:     sdkschema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config": {
+				Type:     sdkschema.TypeList,
+				Optional: true,
+				Elem: &sdkschema.Resource{
+					Schema: map[string]*sdkschema.Schema{
+						"size": {
+							Type:     sdkschema.TypeInt,
+							Optional: true,
+							Default:  10,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	testCases := map[string]struct {
+		path         *tftypes.AttributePath
+		wantForceNew bool
+		wantDefault  string
+	}{
+		"ForceNew": {
+			path:         tftypes.NewAttributePath().WithAttributeName("name"),
+			wantForceNew: true,
+		},
+		"nested Default": {
+			path:        tftypes.NewAttributePath().WithAttributeName("config").WithElementKeyInt(0).WithAttributeName("size"),
+			wantDefault: "10",
+		},
+		"not found": {
+			path: tftypes.NewAttributePath().WithAttributeName("name").WithAttributeName("size"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			forceNew, defaultValue := details(ctx, testCase.path)
+
+			if got, want := forceNew, testCase.wantForceNew; got != want {
+				t.Errorf("ForceNew = %t, want %t", got, want)
+			}
+			if got, want := defaultValue, testCase.wantDefault; got != want {
+				t.Errorf("Default = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFrameworkDetails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	details := FrameworkDetails(fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": fwschema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"config": fwschema.ListNestedBlock{
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"size": fwschema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(10),
+						},
+					},
+				},
+			},
+		},
+	})
+
+	testCases := map[string]struct {
+		path         *tftypes.AttributePath
+		wantForceNew bool
+		wantDefault  string
+	}{
+		"RequiresReplace": {
+			path:         tftypes.NewAttributePath().WithAttributeName("name"),
+			wantForceNew: true,
+		},
+		"block": {
+			path: tftypes.NewAttributePath().WithAttributeName("config"),
+		},
+		"nested Default": {
+			path:        tftypes.NewAttributePath().WithAttributeName("config").WithElementKeyInt(0).WithAttributeName("size"),
+			wantDefault: "10",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			forceNew, defaultValue := details(ctx, testCase.path)
+
+			if got, want := forceNew, testCase.wantForceNew; got != want {
+				t.Errorf("ForceNew = %t, want %t", got, want)
+			}
+			if got, want := defaultValue, testCase.wantDefault; got != want {
+				t.Errorf("Default = %q, want %q", got, want)
+			}
+		})
+	}
+}
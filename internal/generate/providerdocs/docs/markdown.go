@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Section identifies a reference section of a documentation page.
+type Section int
+
+const (
+	SectionNone Section = iota
+	SectionArguments
+	SectionAttributes
+)
+
+// Item is a documented argument or attribute.
+type Item struct {
+	Name string
+	// Path is the name as documented, e.g. `foo.0.bar`.
+	Path    string
+	Section Section
+	Text    string
+	Line    int
+}
+
+// Document is the parsed Argument Reference and Attribute Reference sections of a documentation page.
+type Document struct {
+	Items []Item
+}
+
+var (
+	// e.g. "* `name` - (Optional) Description."
+	itemRegexp = regexp.MustCompile("^\\s*[*-]\\s+`([^`]+)`\\s*-?\\s*(.*)$")
+	nameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// ParseDocument parses the documentation page read from r.
+// Only list items in the Argument Reference and Attribute Reference sections, including any subsections, are considered.
+func ParseDocument(r io.Reader) (*Document, error) {
+	doc := &Document{}
+	section := SectionNone
+	inCodeBlock := false
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(text), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		if v, ok := strings.CutPrefix(text, "## "); ok {
+			switch strings.TrimSpace(v) {
+			case "Argument Reference", "Arguments Reference":
+				section = SectionArguments
+			case "Attribute Reference", "Attributes Reference":
+				section = SectionAttributes
+			default:
+				section = SectionNone
+			}
+			continue
+		}
+
+		if section == SectionNone {
+			continue
+		}
+
+		if m := itemRegexp.FindStringSubmatch(text); m != nil && nameRegexp.MatchString(itemName(m[1])) {
+			doc.Items = append(doc.Items, Item{
+				Name:    itemName(m[1]),
+				Path:    m[1],
+				Section: section,
+				Text:    m[2],
+				Line:    line,
+			})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// itemName returns the attribute name of a documented item.
+// Items are sometimes documented with their full path, e.g. `foo.0.bar` or `foo[*].bar`.
+func itemName(s string) string {
+	if i := strings.LastIndex(s, "."); i >= 0 {
+		s = s[i+1:]
+	}
+
+	return s
+}
+
+// Names returns the set of documented names, including the parents of items documented with their full path.
+func (d *Document) Names() map[string]bool {
+	names := make(map[string]bool)
+
+	for _, item := range d.Items {
+		for _, v := range strings.FieldsFunc(item.Path, func(r rune) bool { return r == '.' || r == '[' || r == ']' }) {
+			if nameRegexp.MatchString(v) {
+				names[v] = true
+			}
+		}
+	}
+
+	return names
+}
+
+// Item returns the first documented item with the specified name in the specified section.
+func (d *Document) Item(name string, section Section) (Item, bool) {
+	for _, item := range d.Items {
+		if item.Name == name && item.Section == section {
+			return item, true
+		}
+	}
+
+	return Item{}, false
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testDocument = "---\n" +
+	"subcategory: \"Example\"\n" +
+	"---\n" +
+	"\n" +
+	"# Resource: aws_example_thing\n" +
+	"\n" +
+	"## Example Usage\n" +
+	"\n" +
+	"```terraform\n" +
+	"resource \"aws_example_thing\" \"example\" {\n" +
+	"  * `not_an_item` - In a code block.\n" +
+	"}\n" +
+	"```\n" +
+	"\n" +
+	"## Argument Reference\n" +
+	"\n" +
+	"* `name` - (Required) Name of the thing.\n" +
+	"* `config` - (Optional) Configuration. See below.\n" +
+	"\n" +
+	"### config\n" +
+	"\n" +
+	"* `size` - (Optional) Size.\n" +
+	"\n" +
+	"## Attribute Reference\n" +
+	"\n" +
+	"* `arn` - ARN of the thing.\n" +
+	"* `status.0.code` - Status code.\n" +
+	"* `Not a name` - Ignored.\n" +
+	"\n" +
+	"## Import\n" +
+	"\n" +
+	"* `ignored` - Not in a reference section.\n"
+
+func TestParseDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := ParseDocument(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []Item{
+		{Name: "name", Path: "name", Section: SectionArguments, Text: "(Required) Name of the thing.", Line: 17},
+		{Name: "config", Path: "config", Section: SectionArguments, Text: "(Optional) Configuration. See below.", Line: 18},
+		{Name: "size", Path: "size", Section: SectionArguments, Text: "(Optional) Size.", Line: 22},
+		{Name: "arn", Path: "arn", Section: SectionAttributes, Text: "ARN of the thing.", Line: 26},
+		{Name: "code", Path: "status.0.code", Section: SectionAttributes, Text: "Status code.", Line: 27},
+	}
+
+	if diff := cmp.Diff(doc.Items, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
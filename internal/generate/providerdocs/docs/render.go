@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Render returns the Argument Reference and Attribute Reference sections for an entity.
+// Descriptions are taken from the schema. Where the schema has none, a TODO placeholder is used.
+func Render(e *Entity) string {
+	var sb strings.Builder
+
+	entityKind := "resource"
+	if e.IsDataSource {
+		entityKind = "data source"
+	}
+
+	sb.WriteString("## Argument Reference\n\n")
+
+	var required, optional, computed []*Attribute
+	for _, attr := range e.Attributes {
+		switch {
+		case undocumentedBlocks[attr.Name]:
+		case attr.Required:
+			required = append(required, attr)
+		case attr.Optional:
+			optional = append(optional, attr)
+		default:
+			computed = append(computed, attr)
+		}
+	}
+
+	if len(required) == 0 && len(optional) == 0 {
+		fmt.Fprintf(&sb, "This %s does not support any arguments.\n\n", entityKind)
+	}
+	if len(required) > 0 {
+		sb.WriteString("The following arguments are required:\n\n")
+		renderItems(&sb, e.IsDataSource, required)
+	}
+	if len(optional) > 0 {
+		sb.WriteString("The following arguments are optional:\n\n")
+		renderItems(&sb, e.IsDataSource, optional)
+	}
+
+	renderBlocks(&sb, e.IsDataSource, e.Attributes, true)
+
+	sb.WriteString("## Attribute Reference\n\n")
+	fmt.Fprintf(&sb, "This %s exports the following attributes in addition to the arguments above:\n\n", entityKind)
+	renderItems(&sb, e.IsDataSource, computed)
+
+	renderBlocks(&sb, e.IsDataSource, e.Attributes, false)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// renderBlocks renders a subsection for each nested block (arguments) or computed object (attributes), recursively.
+func renderBlocks(sb *strings.Builder, isDataSource bool, attrs []*Attribute, arguments bool) {
+	for _, attr := range attrs {
+		if undocumentedBlocks[attr.Name] || len(attr.Attributes) == 0 || attr.IsArgument() != arguments {
+			continue
+		}
+
+		fmt.Fprintf(sb, "### `%s` Block\n\n", attr.Name)
+		if arguments {
+			fmt.Fprintf(sb, "The `%s` configuration block supports the following arguments:\n\n", attr.Name)
+		} else {
+			fmt.Fprintf(sb, "The `%s` attribute exports the following attributes:\n\n", attr.Name)
+		}
+		renderItems(sb, isDataSource, attr.Attributes)
+
+		renderBlocks(sb, isDataSource, attr.Attributes, arguments)
+	}
+}
+
+func renderItems(sb *strings.Builder, isDataSource bool, attrs []*Attribute) {
+	if len(attrs) == 0 {
+		return
+	}
+
+	for _, attr := range attrs {
+		fmt.Fprintf(sb, "* `%s` - %s\n", attr.Name, itemText(isDataSource, attr))
+	}
+
+	sb.WriteString("\n")
+}
+
+func itemText(isDataSource bool, attr *Attribute) string {
+	var parts []string
+
+	switch {
+	case attr.Required:
+		parts = append(parts, "(Required)")
+	case attr.Optional:
+		parts = append(parts, "(Optional)")
+	}
+
+	description := strings.TrimSpace(attr.Description)
+	if description == "" {
+		description = "TODO: Concise description."
+	} else if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	parts = append(parts, description)
+
+	if attr.IsBlock {
+		switch attr.MaxItems {
+		case 0:
+			parts = append(parts, fmt.Sprintf("See [`%s` Block](#%s-block) below.", attr.Name, anchor(attr.Name)))
+		case 1:
+			parts = append(parts, fmt.Sprintf("At most one block. See [`%s` Block](#%s-block) below.", attr.Name, anchor(attr.Name)))
+		default:
+			parts = append(parts, fmt.Sprintf("At most %d blocks. See [`%s` Block](#%s-block) below.", attr.MaxItems, attr.Name, anchor(attr.Name)))
+		}
+	}
+	if attr.Default != "" {
+		parts = append(parts, fmt.Sprintf("Defaults to `%s`.", attr.Default))
+	}
+	if attr.ForceNew && !isDataSource {
+		parts = append(parts, "Changing this value forces a new resource to be created.")
+	}
+	if attr.Deprecated {
+		parts = append(parts, "**Deprecated**.")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// anchor returns the Markdown heading anchor for a name, e.g. `foo_bar` -> `foo_bar`.
+func anchor(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, " ", "-"))
+}
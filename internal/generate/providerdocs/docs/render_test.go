@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	details := func(_ context.Context, path *tftypes.AttributePath) (bool, string) {
+		switch {
+		case path.Equal(tftypes.NewAttributePath().WithAttributeName("name")):
+			return true, ""
+		case path.Equal(tftypes.NewAttributePath().WithAttributeName("config").WithElementKeyInt(0).WithAttributeName("size")):
+			return false, "10"
+		}
+		return false, ""
+	}
+	entity := NewEntity(ctx, "aws_example_thing", false, testSchema(), details)
+
+	want := "## Argument Reference\n" +
+		"\n" +
+		"The following arguments are required:\n" +
+		"\n" +
+		"* `name` - (Required) Name of the thing. Changing this value forces a new resource to be created.\n" +
+		"\n" +
+		"The following arguments are optional:\n" +
+		"\n" +
+		"* `config` - (Optional) TODO: Concise description. At most one block. See [`config` Block](#config-block) below.\n" +
+		"* `id` - (Optional) TODO: Concise description.\n" +
+		"* `old` - (Optional) TODO: Concise description. **Deprecated**.\n" +
+		"\n" +
+		"### `config` Block\n" +
+		"\n" +
+		"The `config` configuration block supports the following arguments:\n" +
+		"\n" +
+		"* `size` - (Optional) TODO: Concise description. Defaults to `10`.\n" +
+		"\n" +
+		"## Attribute Reference\n" +
+		"\n" +
+		"This resource exports the following attributes in addition to the arguments above:\n" +
+		"\n" +
+		"* `arn` - TODO: Concise description.\n" +
+		"* `status` - TODO: Concise description.\n" +
+		"\n" +
+		"### `status` Block\n" +
+		"\n" +
+		"The `status` attribute exports the following attributes:\n" +
+		"\n" +
+		"* `code` - TODO: Concise description.\n"
+
+	if diff := cmp.Diff(Render(entity), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
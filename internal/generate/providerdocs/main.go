@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/providerdocs/docs"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+var (
+	dataSourceType = flag.String("data-source", "", "only process this data source type")
+	generate       = flag.Bool("generate", false, "print the Argument Reference and Attribute Reference sections instead of checking")
+	resourceType   = flag.String("resource", "", "only process this resource type")
+	servicePackage = flag.String("service", "", "only process this service package's resources and data sources")
+	websiteDir     = flag.String("website-dir", "website/docs", "website documentation directory")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tproviderdocs [-service <service-package>] [-resource <resource-type>|-data-source <data-source-type>] [-generate] [-website-dir <directory>]\n\n")
+	flag.PrintDefaults()
+}
+
+// entitySource describes a resource or data source registered by a service package.
+type entitySource struct {
+	typeName       string
+	isDataSource   bool
+	servicePackage string
+	details        docs.DetailsFunc
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	if *generate && *resourceType == "" && *dataSourceType == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		g.Fatalf("creating provider: %s", err)
+	}
+
+	schemas, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		g.Fatalf("reading provider schema: %s", err)
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		g.Fatalf("reading provider schema: %s", err)
+	}
+
+	sources, err := entitySources(ctx, primary.Meta().(*conns.AWSClient).ServicePackages)
+	if err != nil {
+		g.Fatalf("reading service packages: %s", err)
+	}
+
+	var findings int
+	for _, source := range sources {
+		if v := *servicePackage; v != "" && source.servicePackage != v {
+			continue
+		}
+		if v := *resourceType; v != "" && (source.isDataSource || source.typeName != v) {
+			continue
+		}
+		if v := *dataSourceType; v != "" && (!source.isDataSource || source.typeName != v) {
+			continue
+		}
+
+		var schema *tfprotov5.Schema
+		if source.isDataSource {
+			schema = schemas.DataSourceSchemas[source.typeName]
+		} else {
+			schema = schemas.ResourceSchemas[source.typeName]
+		}
+		entity := docs.NewEntity(ctx, source.typeName, source.isDataSource, schema, source.details)
+
+		if *generate {
+			fmt.Println(docs.Render(entity))
+			return
+		}
+
+		filename, err := documentationFilename(*websiteDir, entity)
+		if err != nil {
+			g.Errorf("%s: %s", entity.TypeName, err)
+			findings++
+			continue
+		}
+
+		file, err := os.Open(filename)
+		if err != nil {
+			g.Fatalf("opening %s: %s", filename, err)
+		}
+		doc, err := docs.ParseDocument(file)
+		file.Close()
+		if err != nil {
+			g.Fatalf("parsing %s: %s", filename, err)
+		}
+
+		for _, finding := range docs.Check(entity, doc) {
+			g.Errorf("%s:%s", filename, finding)
+			findings++
+		}
+	}
+
+	if *generate {
+		g.Fatalf("type not found")
+	}
+
+	if findings > 0 {
+		g.Fatalf("%d documentation findings", findings)
+	}
+}
+
+// entitySources returns all resources and data sources registered by the specified service packages, sorted by type name.
+func entitySources(ctx context.Context, servicePackages map[string]conns.ServicePackage) ([]entitySource, error) {
+	var sources []entitySource
+
+	for name, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			sources = append(sources, entitySource{
+				typeName:       v.TypeName,
+				servicePackage: name,
+				details:        docs.SDKDetails(v.Factory()),
+			})
+		}
+
+		for _, v := range sp.SDKDataSources(ctx) {
+			sources = append(sources, entitySource{
+				typeName:       v.TypeName,
+				isDataSource:   true,
+				servicePackage: name,
+			})
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("creating resource (%s): %w", v.Name, err)
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+			schemaResponse := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			sources = append(sources, entitySource{
+				typeName:       metadataResponse.TypeName,
+				servicePackage: name,
+				details:        docs.FrameworkDetails(schemaResponse.Schema),
+			})
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			d, err := v.Factory(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("creating data source (%s): %w", v.Name, err)
+			}
+
+			metadataResponse := datasource.MetadataResponse{}
+			d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+			sources = append(sources, entitySource{
+				typeName:       metadataResponse.TypeName,
+				isDataSource:   true,
+				servicePackage: name,
+			})
+		}
+	}
+
+	slices.SortFunc(sources, func(a, b entitySource) int {
+		if a.isDataSource != b.isDataSource {
+			if a.isDataSource {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.typeName, b.typeName)
+	})
+
+	return sources, nil
+}
+
+// documentationFilename returns the name of an entity's documentation page.
+func documentationFilename(dir string, e *docs.Entity) (string, error) {
+	subdir := "r"
+	if e.IsDataSource {
+		subdir = "d"
+	}
+
+	name := strings.TrimPrefix(e.TypeName, "aws_")
+	for _, ext := range []string{".html.markdown", ".markdown"} {
+		filename := filepath.Join(dir, subdir, name+ext)
+		if _, err := os.Stat(filename); err == nil {
+			return filename, nil
+		}
+	}
+
+	return "", fmt.Errorf("no documentation page in %s", filepath.Join(dir, subdir))
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
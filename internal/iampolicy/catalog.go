@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Catalog is the set of IAM actions known to the provider, keyed by service prefix.
+type Catalog struct {
+	// Complete is true if the catalog contains every service prefix.
+	// Unknown service prefixes are only reported for a complete catalog.
+	Complete bool                `json:"complete"`
+	Services map[string]*Service `json:"services"`
+}
+
+// Service is the IAM actions and resource ARN service namespaces of a single service prefix.
+type Service struct {
+	// Actions are the service's action names. If empty, action names aren't checked.
+	Actions []string `json:"actions"`
+	// ResourceServices are the service namespaces of the ARNs of the service's resource types,
+	// e.g. `sts` actions apply to `iam` roles. If empty, the service prefix is used.
+	ResourceServices []string `json:"resource_services,omitempty"`
+}
+
+//go:embed actions.json
+var catalogJSON []byte
+
+var defaultCatalog = sync.OnceValue(func() *Catalog {
+	var catalog Catalog
+
+	if err := json.Unmarshal(catalogJSON, &catalog); err != nil {
+		panic(err)
+	}
+
+	return &catalog
+})
+
+// DefaultCatalog returns the action catalog bundled with the provider.
+func DefaultCatalog() *Catalog {
+	return defaultCatalog()
+}
+
+// service returns the catalog entry for a service prefix, case-insensitively.
+func (c *Catalog) service(prefix string) (*Service, bool) {
+	v, ok := c.Services[strings.ToLower(prefix)]
+
+	return v, ok
+}
+
+// hasAction returns whether any of the service's actions matches the specified action name, which may contain wildcards.
+// Action names are case-insensitive.
+func (s *Service) hasAction(name string) bool {
+	return slices.ContainsFunc(s.Actions, func(action string) bool {
+		return wildcardMatch(strings.ToLower(name), strings.ToLower(action))
+	})
+}
+
+// resourceServices returns the ARN service namespaces that the service's actions apply to.
+func (s *Service) resourceServices(prefix string) []string {
+	if len(s.ResourceServices) == 0 {
+		return []string{prefix}
+	}
+
+	return s.ResourceServices
+}
+
+// wildcardMatch reports whether s matches pattern, where `*` matches any sequence of characters and `?` matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := 0; i <= len(s); i++ {
+				if wildcardMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || pattern[0] != s[0] {
+				return false
+			}
+		}
+		pattern, s = pattern[1:], s[1:]
+	}
+
+	return len(s) == 0
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Finding is a potential problem in a policy document.
+type Finding struct {
+	// Statement identifies the statement, by Sid if it has one, otherwise by 1-based index.
+	Statement string
+	Message   string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("statement %s: %s", f.Statement, f.Message)
+}
+
+// Lint checks a policy document against an action catalog.
+// It reports
+//   - malformed action names and action names that aren't in the catalog
+//   - condition operators that aren't valid
+//   - Allow statements with a wildcard NotAction
+//   - resource ARNs whose service doesn't match the service of any of the statement's actions
+//
+// Action names are only checked for service prefixes in the catalog.
+func Lint(catalog *Catalog, policy string) ([]Finding, error) {
+	var doc policyDocument
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for i, s := range doc.Statement {
+		id := strconv.Itoa(i + 1)
+		if s.Sid != "" {
+			id = strconv.Quote(s.Sid)
+		}
+
+		for _, message := range s.lint(catalog) {
+			findings = append(findings, Finding{
+				Statement: id,
+				Message:   message,
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+type policyDocument struct {
+	Statement statements `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid         string                                `json:"Sid"`
+	Effect      string                                `json:"Effect"`
+	Action      stringOrSlice                         `json:"Action"`
+	NotAction   stringOrSlice                         `json:"NotAction"`
+	Resource    stringOrSlice                         `json:"Resource"`
+	NotResource stringOrSlice                         `json:"NotResource"`
+	Condition   map[string]map[string]json.RawMessage `json:"Condition"`
+}
+
+func (s *policyStatement) lint(catalog *Catalog) []string {
+	var messages []string
+
+	for _, action := range slices.Concat(s.Action, s.NotAction) {
+		if message := lintAction(catalog, action); message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	if strings.EqualFold(s.Effect, "Allow") {
+		for _, action := range s.NotAction {
+			if strings.ContainsAny(action, "*?") {
+				messages = append(messages, fmt.Sprintf("Allow with NotAction %q allows every action that doesn't match the wildcard", action))
+			}
+		}
+	}
+
+	operators := make([]string, 0, len(s.Condition))
+	for operator := range s.Condition {
+		operators = append(operators, operator)
+	}
+	slices.Sort(operators)
+	for _, operator := range operators {
+		if !validConditionOperator(operator) {
+			messages = append(messages, fmt.Sprintf("invalid condition operator %q", operator))
+		}
+	}
+
+	messages = append(messages, lintResources(catalog, s.Action, s.Resource)...)
+
+	return messages
+}
+
+// lintAction returns a message if an action name is malformed or unknown.
+func lintAction(catalog *Catalog, action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok || prefix == "" || name == "" || strings.Contains(name, ":") {
+		return fmt.Sprintf("invalid action %q, expected <service>:<action>", action)
+	}
+
+	if strings.ContainsAny(prefix, "*?") {
+		return ""
+	}
+
+	service, ok := catalog.service(prefix)
+	if !ok {
+		if catalog.Complete {
+			return fmt.Sprintf("unknown service prefix in action %q", action)
+		}
+		return ""
+	}
+
+	if len(service.Actions) > 0 && !service.hasAction(name) {
+		return fmt.Sprintf("unknown action %q", action)
+	}
+
+	return ""
+}
+
+// lintResources returns a message for each resource ARN whose service namespace doesn't match that of any action.
+// Statements with a wildcard action service prefix, or with an action whose service isn't in the catalog, aren't checked.
+// A service's resource ARNs may be in other namespaces, e.g. `sts` actions apply to `iam` roles, so the namespaces of
+// services that aren't in the catalog are unknown.
+func lintResources(catalog *Catalog, actions, resources []string) []string {
+	var resourceServices []string
+
+	for _, action := range actions {
+		prefix, _, ok := strings.Cut(action, ":")
+		if !ok || strings.ContainsAny(prefix, "*?") {
+			return nil
+		}
+
+		prefix = strings.ToLower(prefix)
+		service, ok := catalog.service(prefix)
+		if !ok {
+			return nil
+		}
+
+		resourceServices = append(resourceServices, service.resourceServices(prefix)...)
+	}
+
+	if len(resourceServices) == 0 {
+		return nil
+	}
+
+	var messages []string
+
+	for _, resource := range resources {
+		parts := strings.SplitN(resource, ":", 4)
+		if len(parts) < 4 || parts[0] != "arn" {
+			continue
+		}
+
+		service := parts[2]
+		if service == "" || strings.ContainsAny(service, "*?$") {
+			continue
+		}
+
+		if !slices.Contains(resourceServices, strings.ToLower(service)) {
+			messages = append(messages, fmt.Sprintf("resource %q is in service %q, which doesn't match the service of any action", resource, service))
+		}
+	}
+
+	return messages
+}
+
+var conditionOperators = []string{
+	"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+	"IpAddress", "NotIpAddress",
+	"Null",
+	"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+	"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+}
+
+// validConditionOperator returns whether an operator, including any set operator prefix or IfExists suffix, is valid.
+func validConditionOperator(operator string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if v, ok := cutPrefixFold(operator, prefix); ok {
+			operator = v
+			break
+		}
+	}
+
+	if v, ok := cutSuffixFold(operator, "IfExists"); ok && !strings.EqualFold(v, "Null") {
+		operator = v
+	}
+
+	return slices.ContainsFunc(conditionOperators, func(v string) bool {
+		return strings.EqualFold(v, operator)
+	})
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		return s[len(prefix):], true
+	}
+
+	return s, false
+}
+
+func cutSuffixFold(s, suffix string) (string, bool) {
+	if len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s[:len(s)-len(suffix)], true
+	}
+
+	return s, false
+}
+
+// statements is a policy's Statement element, which is either a single statement or an array of statements.
+type statements []*policyStatement
+
+func (s *statements) UnmarshalJSON(b []byte) error {
+	var statement policyStatement
+
+	if err := json.Unmarshal(b, &statement); err == nil {
+		*s = statements{&statement}
+		return nil
+	}
+
+	var v []*policyStatement
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*s = v
+
+	return nil
+}
+
+// stringOrSlice is a policy element that is either a single string or an array of strings.
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(b []byte) error {
+	var str string
+
+	if err := json.Unmarshal(b, &str); err == nil {
+		*s = stringOrSlice{str}
+		return nil
+	}
+
+	var v []string
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*s = v
+
+	return nil
+}
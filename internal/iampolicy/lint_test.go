@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func testCatalog() *iampolicy.Catalog {
+	return &iampolicy.Catalog{
+		Services: map[string]*iampolicy.Service{
+			"s3": {
+				Actions: []string{"GetObject", "ListBucket", "PutObject"},
+			},
+			"ssm": {
+				ResourceServices: []string{"ec2", "ssm"},
+			},
+			"sts": {
+				Actions:          []string{"AssumeRole", "GetCallerIdentity"},
+				ResourceServices: []string{"iam", "sts"},
+			},
+		},
+	}
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		catalog  *iampolicy.Catalog
+		policy   string
+		expected []iampolicy.Finding
+	}{
+		"no findings": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:list*", "sts:AssumeRole", "ec2:DescribeInstances", "*"],
+    "Resource": "*"
+  }]
+}`,
+		},
+		"single statement": {
+			policy: `{"Statement": {"Effect": "Allow", "Action": "s3:GetObjects", "Resource": "*"}}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `unknown action "s3:GetObjects"`},
+			},
+		},
+		"unknown action": {
+			policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "Typo", "Effect": "Deny", "NotAction": ["s3:Get*", "s3:Describe*"], "Resource": "*"}
+  ]
+}`,
+			expected: []iampolicy.Finding{
+				{Statement: `"Typo"`, Message: `unknown action "s3:Describe*"`},
+			},
+		},
+		"invalid action": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": ["s3GetObject", "s3:", "s3:Get:Object"], "Resource": "*"}]}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `invalid action "s3GetObject", expected <service>:<action>`},
+				{Statement: "1", Message: `invalid action "s3:", expected <service>:<action>`},
+				{Statement: "1", Message: `invalid action "s3:Get:Object", expected <service>:<action>`},
+			},
+		},
+		"unknown service incomplete catalog": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s4:GetObject", "Resource": "*"}]}`,
+		},
+		"unknown service complete catalog": {
+			catalog: &iampolicy.Catalog{Complete: true},
+			policy:  `{"Statement": [{"Effect": "Allow", "Action": "s4:GetObject", "Resource": "*"}]}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `unknown service prefix in action "s4:GetObject"`},
+			},
+		},
+		"Allow NotAction wildcard": {
+			policy: `{"Statement": [{"Effect": "Allow", "NotAction": ["iam:*", "s3:PutObject"], "Resource": "*"}]}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `Allow with NotAction "iam:*" allows every action that doesn't match the wildcard`},
+			},
+		},
+		"Deny NotAction wildcard": {
+			policy: `{"Statement": [{"Effect": "Deny", "NotAction": "iam:*", "Resource": "*"}]}`,
+		},
+		"condition operators": {
+			policy: `{
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": "*",
+    "Condition": {
+      "StringEquals": {"aws:PrincipalTag/team": "a"},
+      "ForAnyValue:StringLikeIfExists": {"aws:TagKeys": "b*"},
+      "Null": {"aws:TokenIssueTime": "true"},
+      "NullIfExists": {"aws:TokenIssueTime": "true"},
+      "StringEqual": {"aws:PrincipalTag/team": "a"},
+      "ForSomeValues:StringEquals": {"aws:TagKeys": "c"}
+    }
+  }]
+}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `invalid condition operator "ForSomeValues:StringEquals"`},
+				{Statement: "1", Message: `invalid condition operator "NullIfExists"`},
+				{Statement: "1", Message: `invalid condition operator "StringEqual"`},
+			},
+		},
+		"resource service": {
+			policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn:aws:s3:::bucket/*", "arn:aws:sqs:us-west-2:123456789012:queue", "arn:${AWS::Partition}:*:*:*:*"]},
+    {"Effect": "Allow", "Action": "sts:AssumeRole", "Resource": "arn:aws:iam::123456789012:role/example"},
+    {"Effect": "Allow", "Action": "ssm:SendCommand", "Resource": ["arn:aws:ec2:us-west-2:123456789012:instance/*", "arn:aws:ssm:us-west-2::document/AWS-RunShellScript"]},
+    {"Effect": "Allow", "Action": ["s3:GetObject", "sqs:SendMessage"], "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"},
+    {"Effect": "Allow", "Action": "s3:*", "NotResource": "arn:aws:sqs:us-west-2:123456789012:queue"},
+    {"Effect": "Allow", "Action": "*", "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"}
+  ]
+}`,
+			expected: []iampolicy.Finding{
+				{Statement: "1", Message: `resource "arn:aws:sqs:us-west-2:123456789012:queue" is in service "sqs", which doesn't match the service of any action`},
+			},
+		},
+		"resource service not in catalog": {
+			policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "lambda:InvokeFunction", "Resource": "arn:aws:states:us-west-2:123456789012:stateMachine:example"},
+    {"Effect": "Allow", "Action": ["s3:GetObject", "kms:Decrypt"], "Resource": "arn:aws:ec2:us-west-2:123456789012:instance/*"}
+  ]
+}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog := testCase.catalog
+			if catalog == nil {
+				catalog = testCatalog()
+			}
+
+			got, err := iampolicy.Lint(catalog, testCase.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLintInvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	if _, err := iampolicy.Lint(testCatalog(), `{"Statement": "Allow"}`); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDefaultCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := iampolicy.DefaultCatalog()
+
+	if len(catalog.Services) == 0 {
+		t.Fatal("expected services")
+	}
+
+	findings, err := iampolicy.Lint(catalog, `{"Statement": [{"Effect": "Allow", "Action": ["sqs:SendMessage", "sqs:SendMesage"], "Resource": "*"}]}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(findings), 1; got != want {
+		t.Errorf("len(findings) = %d, want = %d", got, want)
+	}
+}
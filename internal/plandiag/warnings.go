@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plandiag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type contextKey int
+
+const warningsKey contextKey = iota
+
+type warnings struct {
+	diags []*tfprotov5.Diagnostic
+}
+
+// NewContext returns a context that collects the warnings added while planning a resource change.
+// Plugin SDK v2 CustomizeDiff functions can only return an error, so warnings are passed back to
+// the provider server through the context.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey, &warnings{})
+}
+
+// AddWarning adds a warning to the context's collected warnings.
+// If the context doesn't collect warnings, the warning is logged.
+func AddWarning(ctx context.Context, summary, detail string) {
+	v, ok := ctx.Value(warningsKey).(*warnings)
+
+	if !ok {
+		tflog.Warn(ctx, summary, map[string]any{
+			"detail": detail,
+		})
+
+		return
+	}
+
+	v.diags = append(v.diags, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// Warnings returns the warnings collected by the context.
+func Warnings(ctx context.Context) []*tfprotov5.Diagnostic {
+	if v, ok := ctx.Value(warningsKey).(*warnings); ok {
+		return v.diags
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plandiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/plandiag"
+)
+
+func TestWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	plandiag.AddWarning(ctx, "not collected", "")
+
+	if got := plandiag.Warnings(ctx); got != nil {
+		t.Errorf("unexpected warnings: %v", got)
+	}
+
+	ctx = plandiag.NewContext(ctx)
+
+	plandiag.AddWarning(ctx, "first", "one")
+	plandiag.AddWarning(context.WithValue(ctx, struct{}{}, nil), "second", "two")
+
+	want := []*tfprotov5.Diagnostic{
+		{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "first", Detail: "one"},
+		{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "second", Detail: "two"},
+	}
+
+	if diff := cmp.Diff(plandiag.Warnings(ctx), want); diff != "" {
+		t.Errorf("unexpected warnings diff (+wanted, -got): %s", diff)
+	}
+}
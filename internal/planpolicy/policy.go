@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpolicy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Enforcement determines whether a policy violation blocks the plan.
+type Enforcement string
+
+const (
+	EnforcementError   Enforcement = "error"
+	EnforcementWarning Enforcement = "warning"
+)
+
+func (Enforcement) Values() []Enforcement {
+	return []Enforcement{
+		EnforcementError,
+		EnforcementWarning,
+	}
+}
+
+const (
+	// Variables available to policy expressions.
+	varPlanned      = "planned"
+	varResourceType = "resource_type"
+)
+
+// Config is the configuration of a single policy, from a `plan_policy` provider configuration block.
+type Config struct {
+	Path        string
+	Enforcement Enforcement
+}
+
+// Policy is a compiled CEL policy expression.
+type Policy struct {
+	name        string
+	enforcement Enforcement
+	program     cel.Program
+}
+
+// Policies are the plan policies configured for a provider instance.
+type Policies []*Policy
+
+// Load reads and compiles the policy files.
+// A policy file contains a single CEL expression, which is evaluated with the variables
+//   - `resource_type`, the resource type name, e.g. `aws_s3_bucket`
+//   - `planned`, the planned resource values as a map. Values not known until apply are null.
+//
+// The expression evaluates to `true` if the plan complies with the policy, `false` otherwise.
+// Alternatively, the expression evaluates to a string or list of strings describing each violation.
+// An empty string or list indicates compliance.
+func Load(configs []Config) (Policies, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(varPlanned, cel.DynType),
+		cel.Variable(varResourceType, cel.StringType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies Policies
+
+	for _, config := range configs {
+		policy, err := newPolicy(env, config)
+		if err != nil {
+			return nil, fmt.Errorf("loading plan policy (%s): %w", config.Path, err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func newPolicy(env *cel.Env, config Config) (*Policy, error) {
+	enforcement := config.Enforcement
+	switch enforcement {
+	case "":
+		enforcement = EnforcementError
+	case EnforcementError, EnforcementWarning:
+	default:
+		return nil, fmt.Errorf("invalid enforcement %q", enforcement)
+	}
+
+	source, err := os.ReadFile(config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(string(source))
+	if err := issues.Err(); err != nil {
+		return nil, err
+	}
+
+	switch t := ast.OutputType(); {
+	case t.IsExactType(cel.BoolType), t.IsExactType(cel.StringType), t.IsExactType(cel.ListType(cel.StringType)), t.IsExactType(cel.DynType):
+	default:
+		return nil, fmt.Errorf("expression must evaluate to bool, string or list(string), got %s", t)
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		name:        filepath.Base(config.Path),
+		enforcement: enforcement,
+		program:     program,
+	}, nil
+}
+
+// Violation is a policy violation by a planned resource.
+type Violation struct {
+	Enforcement Enforcement
+	Message     string
+	Policy      string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Policy, v.Message)
+}
+
+// Evaluate evaluates all policies against a resource's planned values.
+// planned is the value returned by FromCty or FromTerraform.
+// An error evaluating a policy is reported as a violation of that policy.
+func (p Policies) Evaluate(ctx context.Context, resourceType string, planned any) []Violation {
+	var violations []Violation
+
+	for _, policy := range p {
+		messages, err := policy.evaluate(ctx, resourceType, planned)
+
+		if err != nil {
+			messages = []string{fmt.Sprintf("evaluating policy: %s", err)}
+		}
+
+		for _, message := range messages {
+			violations = append(violations, Violation{
+				Enforcement: policy.enforcement,
+				Message:     message,
+				Policy:      policy.name,
+			})
+		}
+	}
+
+	return violations
+}
+
+func (p *Policy) evaluate(ctx context.Context, resourceType string, planned any) ([]string, error) {
+	out, _, err := p.program.ContextEval(ctx, map[string]any{
+		varPlanned:      planned,
+		varResourceType: resourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := violationMessages(out)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) > 0 {
+		tflog.Debug(ctx, "plan policy violated", map[string]any{
+			"tf_aws.plan_policy":        p.name,
+			"tf_aws.plan_policy.result": strings.Join(messages, "; "),
+		})
+	}
+
+	return messages, nil
+}
+
+// violationMessages returns the violations described by the result of evaluating a policy expression.
+func violationMessages(v ref.Val) ([]string, error) {
+	switch v := v.(type) {
+	case types.Bool:
+		if v {
+			return nil, nil
+		}
+		return []string{"planned values do not comply with the policy"}, nil
+
+	case types.String:
+		if v == "" {
+			return nil, nil
+		}
+		return []string{string(v)}, nil
+
+	case traits.Lister:
+		var messages []string
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			s, ok := it.Next().(types.String)
+			if !ok {
+				return nil, fmt.Errorf("list elements must be strings")
+			}
+			messages = append(messages, string(s))
+		}
+		return messages, nil
+
+	default:
+		return nil, fmt.Errorf("expression must evaluate to bool, string or list(string), got %s", v.Type())
+	}
+}
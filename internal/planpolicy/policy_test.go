@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpolicy_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+)
+
+func writePolicy(t *testing.T, name, source string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(source), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		source        string
+		enforcement   planpolicy.Enforcement
+		expectedError string
+	}{
+		"bool": {
+			source: `resource_type != "aws_s3_bucket_acl" || planned.acl != "public-read"`,
+		},
+		"list": {
+			source:      `resource_type == "aws_db_instance" && !planned.storage_encrypted ? ["storage must be encrypted"] : []`,
+			enforcement: planpolicy.EnforcementWarning,
+		},
+		"syntax error": {
+			source:        `resource_type ==`,
+			expectedError: "Syntax error",
+		},
+		"undeclared variable": {
+			source:        `config.acl == "private"`,
+			expectedError: "undeclared reference to 'config'",
+		},
+		"output type": {
+			source:        `1 + 1`,
+			expectedError: "expression must evaluate to bool, string or list(string), got int",
+		},
+		"invalid enforcement": {
+			source:        `true`,
+			enforcement:   "block",
+			expectedError: `invalid enforcement "block"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writePolicy(t, "policy.cel", testCase.source)
+
+			_, err := planpolicy.Load([]planpolicy.Config{{Path: path, Enforcement: testCase.enforcement}})
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Parallel()
+
+	if _, err := planpolicy.Load([]planpolicy.Config{{Path: filepath.Join(t.TempDir(), "missing.cel")}}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	policies, err := planpolicy.Load([]planpolicy.Config{
+		{
+			Path: writePolicy(t, "no_public_acls.cel", `
+resource_type != "aws_s3_bucket_acl" || !(planned.acl in ["public-read", "public-read-write"])
+`),
+		},
+		{
+			Path: writePolicy(t, "rds_encrypted.cel", `
+resource_type == "aws_db_instance" && planned.storage_encrypted != true
+  ? ["storage_encrypted must be true for " + planned.identifier]
+  : []
+`),
+			Enforcement: planpolicy.EnforcementWarning,
+		},
+		{
+			Path: writePolicy(t, "instance_size.cel", `
+resource_type == "aws_db_instance" && planned.allocated_storage > 1000 ? "allocated_storage exceeds 1000" : ""
+`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		resourceType string
+		planned      any
+		expected     []planpolicy.Violation
+	}{
+		"compliant": {
+			resourceType: "aws_s3_bucket_acl",
+			planned: map[string]any{
+				"acl": "private",
+			},
+		},
+		"other resource type": {
+			resourceType: "aws_sqs_queue",
+			planned: map[string]any{
+				"name": "example",
+			},
+		},
+		"bool violation": {
+			resourceType: "aws_s3_bucket_acl",
+			planned: map[string]any{
+				"acl": "public-read",
+			},
+			expected: []planpolicy.Violation{
+				{Enforcement: planpolicy.EnforcementError, Message: "planned values do not comply with the policy", Policy: "no_public_acls.cel"},
+			},
+		},
+		"list and string violations": {
+			resourceType: "aws_db_instance",
+			planned: map[string]any{
+				"allocated_storage": int64(2000),
+				"identifier":        "example",
+				"storage_encrypted": nil,
+			},
+			expected: []planpolicy.Violation{
+				{Enforcement: planpolicy.EnforcementWarning, Message: "storage_encrypted must be true for example", Policy: "rds_encrypted.cel"},
+				{Enforcement: planpolicy.EnforcementError, Message: "allocated_storage exceeds 1000", Policy: "instance_size.cel"},
+			},
+		},
+		"evaluation error": {
+			resourceType: "aws_s3_bucket_acl",
+			planned:      map[string]any{},
+			expected: []planpolicy.Violation{
+				{Enforcement: planpolicy.EnforcementError, Message: "evaluating policy: no such key: acl", Policy: "no_public_acls.cel"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policies.Evaluate(ctx, testCase.resourceType, testCase.planned)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpolicy
+
+import (
+	"math/big"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FromCty converts a Plugin SDK v2 planned value to the value passed to policy expressions.
+// Objects and maps become map[string]any, lists, sets and tuples become []any.
+// Null and unknown values become nil.
+func FromCty(v cty.Value) any {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	switch t := v.Type(); {
+	case t == cty.Bool:
+		return v.True()
+
+	case t == cty.Number:
+		return fromBigFloat(v.AsBigFloat())
+
+	case t == cty.String:
+		return v.AsString()
+
+	case t.IsListType(), t.IsSetType(), t.IsTupleType():
+		s := make([]any, 0, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			s = append(s, FromCty(e))
+		}
+		return s
+
+	case t.IsMapType(), t.IsObjectType():
+		m := make(map[string]any, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			k, e := it.Element()
+			m[k.AsString()] = FromCty(e)
+		}
+		return m
+	}
+
+	return nil
+}
+
+// FromTerraform converts a Plugin Framework planned value to the value passed to policy expressions.
+// Objects and maps become map[string]any, lists, sets and tuples become []any.
+// Null and unknown values become nil.
+func FromTerraform(v tftypes.Value) (any, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	switch t := v.Type(); {
+	case t.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+
+	case t.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+		return fromBigFloat(&f), nil
+
+	case t.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		return s, nil
+
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}), t.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		s := make([]any, 0, len(elems))
+		for _, e := range elems {
+			e, err := FromTerraform(e)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, e)
+		}
+		return s, nil
+
+	case t.Is(tftypes.Map{}), t.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		m := make(map[string]any, len(elems))
+		for k, e := range elems {
+			e, err := FromTerraform(e)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = e
+		}
+		return m, nil
+	}
+
+	return nil, nil
+}
+
+// fromBigFloat returns an int64 for integral values, otherwise a float64.
+func fromBigFloat(f *big.Float) any {
+	if f.IsInt() {
+		if i, accuracy := f.Int64(); accuracy == big.Exact {
+			return i
+		}
+	}
+
+	v, _ := f.Float64()
+
+	return v
+}
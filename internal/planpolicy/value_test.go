@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpolicy_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+)
+
+func TestFromCty(t *testing.T) {
+	t.Parallel()
+
+	v := cty.ObjectVal(map[string]cty.Value{
+		"arn":     cty.UnknownVal(cty.String),
+		"enabled": cty.True,
+		"count":   cty.NumberIntVal(3),
+		"ratio":   cty.NumberFloatVal(0.5),
+		"name":    cty.StringVal("example"),
+		"nothing": cty.NullVal(cty.String),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"Name": cty.StringVal("example"),
+		}),
+		"rule": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"ports": cty.SetVal([]cty.Value{cty.NumberIntVal(443)}),
+			}),
+		}),
+	})
+
+	expected := map[string]any{
+		"arn":     nil,
+		"enabled": true,
+		"count":   int64(3),
+		"ratio":   0.5,
+		"name":    "example",
+		"nothing": nil,
+		"tags": map[string]any{
+			"Name": "example",
+		},
+		"rule": []any{
+			map[string]any{
+				"ports": []any{int64(443)},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(planpolicy.FromCty(v), any(expected)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"ports": tftypes.Set{ElementType: tftypes.Number},
+	}}
+	v := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"arn":     tftypes.String,
+		"enabled": tftypes.Bool,
+		"count":   tftypes.Number,
+		"ratio":   tftypes.Number,
+		"name":    tftypes.String,
+		"nothing": tftypes.String,
+		"tags":    tftypes.Map{ElementType: tftypes.String},
+		"rule":    tftypes.List{ElementType: ruleType},
+	}}, map[string]tftypes.Value{
+		"arn":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"count":   tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+		"ratio":   tftypes.NewValue(tftypes.Number, big.NewFloat(0.5)),
+		"name":    tftypes.NewValue(tftypes.String, "example"),
+		"nothing": tftypes.NewValue(tftypes.String, nil),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Name": tftypes.NewValue(tftypes.String, "example"),
+		}),
+		"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				"ports": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+				}),
+			}),
+		}),
+	})
+
+	expected := map[string]any{
+		"arn":     nil,
+		"enabled": true,
+		"count":   int64(3),
+		"ratio":   0.5,
+		"name":    "example",
+		"nothing": nil,
+		"tags": map[string]any{
+			"Name": "example",
+		},
+		"rule": []any{
+			map[string]any{
+				"ports": []any{int64(443)},
+			},
+		},
+	}
+
+	got, err := planpolicy.FromTerraform(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, any(expected)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preventdestroy
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceFromCty returns the description of a Plugin SDK v2 resource from its prior state.
+func ResourceFromCty(typeName string, state cty.Value) Resource {
+	resource := Resource{
+		TypeName: typeName,
+	}
+
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() {
+		return resource
+	}
+
+	stringAttr := func(name string) string {
+		if !state.Type().HasAttribute(name) {
+			return ""
+		}
+		if v := state.GetAttr(name); v.IsKnown() && !v.IsNull() && v.Type() == cty.String {
+			return v.AsString()
+		}
+		return ""
+	}
+	mapAttr := func(name string) map[string]string {
+		if !state.Type().HasAttribute(name) {
+			return nil
+		}
+		v := state.GetAttr(name)
+		if !v.IsKnown() || v.IsNull() || !v.Type().IsMapType() {
+			return nil
+		}
+		m := make(map[string]string, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			k, e := it.Element()
+			if e.IsKnown() && !e.IsNull() && e.Type() == cty.String {
+				m[k.AsString()] = e.AsString()
+			}
+		}
+		return m
+	}
+
+	resource.ID = stringAttr(names.AttrID)
+	resource.Name = stringAttr(names.AttrName)
+	resource.Tags = mapAttr(names.AttrTagsAll)
+	if resource.Tags == nil {
+		resource.Tags = mapAttr(names.AttrTags)
+	}
+
+	return resource
+}
+
+// ResourceFromTerraform returns the description of a Plugin Framework resource from its prior state.
+func ResourceFromTerraform(typeName string, state tftypes.Value) Resource {
+	resource := Resource{
+		TypeName: typeName,
+	}
+
+	var attrs map[string]tftypes.Value
+	if !state.IsKnown() || state.IsNull() || !state.Type().Is(tftypes.Object{}) || state.As(&attrs) != nil {
+		return resource
+	}
+
+	stringAttr := func(name string) string {
+		var s string
+		if v, ok := attrs[name]; ok && v.IsKnown() && !v.IsNull() && v.Type().Is(tftypes.String) && v.As(&s) == nil {
+			return s
+		}
+		return ""
+	}
+	mapAttr := func(name string) map[string]string {
+		v, ok := attrs[name]
+		if !ok || !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.Map{}) {
+			return nil
+		}
+		var elems map[string]tftypes.Value
+		if v.As(&elems) != nil {
+			return nil
+		}
+		m := make(map[string]string, len(elems))
+		for k, e := range elems {
+			var s string
+			if e.IsKnown() && !e.IsNull() && e.Type().Is(tftypes.String) && e.As(&s) == nil {
+				m[k] = s
+			}
+		}
+		return m
+	}
+
+	resource.ID = stringAttr(names.AttrID)
+	resource.Name = stringAttr(names.AttrName)
+	resource.Tags = mapAttr(names.AttrTagsAll)
+	if resource.Tags == nil {
+		resource.Tags = mapAttr(names.AttrTags)
+	}
+
+	return resource
+}
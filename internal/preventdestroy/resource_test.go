@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preventdestroy_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
+)
+
+func TestResourceFromCty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state    cty.Value
+		expected preventdestroy.Resource
+	}{
+		"null": {
+			state: cty.NullVal(cty.Object(map[string]cty.Type{"id": cty.String})),
+			expected: preventdestroy.Resource{
+				TypeName: "aws_test",
+			},
+		},
+		"tags_all": {
+			state: cty.ObjectVal(map[string]cty.Value{
+				"id":       cty.StringVal("i-12345678"),
+				"name":     cty.StringVal("example"),
+				"tags":     cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("example")}),
+				"tags_all": cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("example"), "Environment": cty.StringVal("production")}),
+			}),
+			expected: preventdestroy.Resource{
+				ID:       "i-12345678",
+				Name:     "example",
+				Tags:     map[string]string{"Name": "example", "Environment": "production"},
+				TypeName: "aws_test",
+			},
+		},
+		"no name or tags_all": {
+			state: cty.ObjectVal(map[string]cty.Value{
+				"id":   cty.StringVal("i-12345678"),
+				"tags": cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("example")}),
+			}),
+			expected: preventdestroy.Resource{
+				ID:       "i-12345678",
+				Tags:     map[string]string{"Name": "example"},
+				TypeName: "aws_test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := preventdestroy.ResourceFromCty("aws_test", testCase.state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":       tftypes.String,
+		"name":     tftypes.String,
+		"tags":     tftypes.Map{ElementType: tftypes.String},
+		"tags_all": tftypes.Map{ElementType: tftypes.String},
+	}}
+
+	testCases := map[string]struct {
+		state    tftypes.Value
+		expected preventdestroy.Resource
+	}{
+		"null": {
+			state: tftypes.NewValue(objectType, nil),
+			expected: preventdestroy.Resource{
+				TypeName: "aws_test",
+			},
+		},
+		"values": {
+			state: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "example"),
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Name": tftypes.NewValue(tftypes.String, "example"),
+				}),
+				"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Environment": tftypes.NewValue(tftypes.String, "production"),
+					"Name":        tftypes.NewValue(tftypes.String, "example"),
+				}),
+			}),
+			expected: preventdestroy.Resource{
+				ID:       "example",
+				Name:     "example",
+				Tags:     map[string]string{"Name": "example", "Environment": "production"},
+				TypeName: "aws_test",
+			},
+		},
+		"null tags_all": {
+			state: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "example"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Name": tftypes.NewValue(tftypes.String, "example"),
+				}),
+				"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+			expected: preventdestroy.Resource{
+				ID:       "example",
+				Tags:     map[string]string{"Name": "example"},
+				TypeName: "aws_test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := preventdestroy.ResourceFromTerraform("aws_test", testCase.state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preventdestroy
+
+import (
+	"fmt"
+	"path"
+	"slices"
+)
+
+// Rule is a provider-level rule, from a `prevent_destroy` provider configuration block, that prevents matching resources from being destroyed.
+// A rule matches a resource if every specified criterion matches.
+type Rule struct {
+	// NamePatterns are shell patterns matched against the resource's name. Any pattern may match.
+	NamePatterns []string
+	// ResourceTypes are shell patterns matched against the resource type name, e.g. `aws_db_*`. Any pattern may match.
+	ResourceTypes []string
+	// Tags must all be present on the resource with the specified values.
+	Tags map[string]string
+}
+
+// Validate returns an error if the rule has no criteria or contains a malformed pattern.
+func (r Rule) Validate() error {
+	if len(r.NamePatterns) == 0 && len(r.ResourceTypes) == 0 && len(r.Tags) == 0 {
+		return fmt.Errorf("at least one of name_patterns, resource_types or tags must be specified")
+	}
+
+	for _, pattern := range slices.Concat(r.NamePatterns, r.ResourceTypes) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Resource describes a resource about to be destroyed.
+type Resource struct {
+	// ID is the resource's `id` attribute.
+	ID string
+	// Name is the resource's `name` attribute, if any.
+	Name string
+	// Tags are the resource's `tags_all` attribute, or `tags` if it has no `tags_all`.
+	Tags     map[string]string
+	TypeName string
+}
+
+// Matches returns whether the rule matches the resource.
+// NamePatterns are matched against the resource's name, or its ID if it has no name.
+func (r Rule) Matches(resource Resource) bool {
+	if len(r.ResourceTypes) > 0 && !matchAny(r.ResourceTypes, resource.TypeName) {
+		return false
+	}
+
+	if len(r.NamePatterns) > 0 {
+		name := resource.Name
+		if name == "" {
+			name = resource.ID
+		}
+
+		if !matchAny(r.NamePatterns, name) {
+			return false
+		}
+	}
+
+	for k, v := range r.Tags {
+		if tag, ok := resource.Tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rules are the prevent_destroy rules configured for a provider instance.
+type Rules []Rule
+
+// Check returns an error if any rule matches the resource.
+func (rs Rules) Check(resource Resource) error {
+	for i, rule := range rs {
+		if rule.Matches(resource) {
+			return fmt.Errorf("destroying %s (%s) is prevented by the provider's prevent_destroy rule %d. "+
+				"To destroy this resource, remove or change the rule in the provider configuration", resource.TypeName, resource.ID, i+1)
+		}
+	}
+
+	return nil
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+
+	return false
+}
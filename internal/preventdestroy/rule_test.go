@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preventdestroy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
+)
+
+func TestRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rule          preventdestroy.Rule
+		expectedError string
+	}{
+		"empty": {
+			rule:          preventdestroy.Rule{},
+			expectedError: "at least one of",
+		},
+		"valid": {
+			rule: preventdestroy.Rule{
+				ResourceTypes: []string{"aws_db_*"},
+			},
+		},
+		"invalid pattern": {
+			rule: preventdestroy.Rule{
+				NamePatterns: []string{"prod-["},
+			},
+			expectedError: `invalid pattern "prod-["`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.rule.Validate()
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestRulesCheck(t *testing.T) {
+	t.Parallel()
+
+	rules := preventdestroy.Rules{
+		{
+			ResourceTypes: []string{"aws_db_instance", "aws_rds_cluster"},
+		},
+		{
+			ResourceTypes: []string{"aws_s3_bucket"},
+			NamePatterns:  []string{"prod-*"},
+		},
+		{
+			Tags: map[string]string{
+				"Environment": "production",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		resource      preventdestroy.Resource
+		expectedError string
+	}{
+		"resource type": {
+			resource: preventdestroy.Resource{
+				ID:       "example",
+				TypeName: "aws_db_instance",
+			},
+			expectedError: "destroying aws_db_instance (example) is prevented by the provider's prevent_destroy rule 1",
+		},
+		"name pattern": {
+			resource: preventdestroy.Resource{
+				ID:       "prod-logs",
+				TypeName: "aws_s3_bucket",
+			},
+			expectedError: "prevent_destroy rule 2",
+		},
+		"name pattern no match": {
+			resource: preventdestroy.Resource{
+				ID:       "dev-logs",
+				TypeName: "aws_s3_bucket",
+			},
+		},
+		"name pattern other type": {
+			resource: preventdestroy.Resource{
+				ID:       "prod-queue",
+				Name:     "prod-queue",
+				TypeName: "aws_sqs_queue",
+			},
+		},
+		"tags": {
+			resource: preventdestroy.Resource{
+				ID:       "vol-12345678",
+				Tags:     map[string]string{"Environment": "production", "Name": "data"},
+				TypeName: "aws_ebs_volume",
+			},
+			expectedError: "prevent_destroy rule 3",
+		},
+		"tags value mismatch": {
+			resource: preventdestroy.Resource{
+				ID:       "vol-12345678",
+				Tags:     map[string]string{"Environment": "staging"},
+				TypeName: "aws_ebs_volume",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := rules.Check(testCase.resource)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
@@ -23,16 +24,38 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
+	factory, err := ProtoV5ProviderServerFactoryWithPrimary(ctx, primary, primary)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return factory, primary, nil
+}
+
+// ProtoV5ProviderServerFactoryWithPrimary returns a muxed terraform-plugin-go protocol v5 provider factory function
+// serving the specified primary (Plugin SDK) provider.
+// The Plugin Framework provider reads its provider instance state from meta, which is normally the primary provider.
+func ProtoV5ProviderServerFactoryWithPrimary(ctx context.Context, primary *schema.Provider, meta interface{ Meta() interface{} }) (func() tfprotov5.ProviderServer, error) {
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
-		providerserver.NewProtocol5(fwprovider.New(primary)),
+		func() tfprotov5.ProviderServer {
+			return newPlanServer(primary.GRPCProvider(), func() *conns.AWSClient {
+				v, _ := primary.Meta().(*conns.AWSClient)
+				return v
+			})
+		},
+		providerserver.NewProtocol5(fwprovider.New(meta)),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	movers := stateMovers(ctx)
+
+	return func() tfprotov5.ProviderServer {
+		return newStateMoveServer(muxServer.ProviderServer(), movers)
+	}, nil
 }
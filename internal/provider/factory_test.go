@@ -7,7 +7,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // go test -bench=BenchmarkProtoV5ProviderServerFactory -benchtime 1x -benchmem -run=B -v ./internal/provider
@@ -22,3 +27,132 @@ func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 		b.Logf("%d resources, %d data sources", len(p.ResourcesMap), len(p.DataSourcesMap))
 	}
 }
+
+func TestProtoV5ProviderServerFactoryGetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("unexpected error diagnostic: %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	// The muxed server combines Plugin SDK and Plugin Framework resources.
+	for _, typeName := range []string{"aws_route53_record", "aws_route53_records"} {
+		if _, ok := response.ResourceSchemas[typeName]; !ok {
+			t.Errorf("missing resource schema: %s", typeName)
+		}
+	}
+}
+
+func TestProtoV5ProviderServerFactoryPreventDestroy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	primary.SetMeta(&conns.AWSClient{
+		PreventDestroyRules: preventdestroy.Rules{
+			{
+				ResourceTypes: []string{"aws_sqs_queue"},
+				NamePatterns:  []string{"prod-*"},
+			},
+		},
+	})
+
+	server := factory()
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const typeName = "aws_sqs_queue"
+	stateType := schemaResponse.ResourceSchemas[typeName].ValueType()
+	objectType, ok := stateType.(tftypes.Object)
+
+	if !ok {
+		t.Fatalf("unexpected %s state type: %T", typeName, stateType)
+	}
+
+	nullValue, err := tfprotov5.NewDynamicValue(stateType, tftypes.NewValue(stateType, nil))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		name      string
+		wantError bool
+	}{
+		"protected": {
+			name:      "prod-queue",
+			wantError: true,
+		},
+		"unprotected": {
+			name: "dev-queue",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for k, v := range objectType.AttributeTypes {
+				attributes[k] = tftypes.NewValue(v, nil)
+			}
+			attributes[names.AttrID] = tftypes.NewValue(tftypes.String, "https://sqs.us-west-2.amazonaws.com/123456789012/"+testCase.name)
+			attributes[names.AttrName] = tftypes.NewValue(tftypes.String, testCase.name)
+
+			priorState, err := tfprotov5.NewDynamicValue(stateType, tftypes.NewValue(stateType, attributes))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Terraform plans a destroy with a null proposed new state and configuration.
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         typeName,
+				PriorState:       &priorState,
+				ProposedNewState: &nullValue,
+				Config:           &nullValue,
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotError bool
+			for _, v := range response.Diagnostics {
+				if v.Severity == tfprotov5.DiagnosticSeverityError {
+					gotError = true
+				}
+			}
+
+			if got, want := gotError, testCase.wantError; got != want {
+				t.Errorf("error diagnostic = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
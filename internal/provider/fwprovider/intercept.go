@@ -12,10 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -255,13 +258,15 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	typeName         string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, typeName string) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		typeName:         typeName,
 	}
 }
 
@@ -337,11 +342,71 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// ModifyPlan calls the resource's ModifyPlan method, if any, and then checks any configured prevent_destroy rules
+// and evaluates any configured plan policies.
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(checkPreventDestroy(w.typeName, request.State.Raw, response.Plan.Raw, len(response.RequiresReplace) > 0, w.meta)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(evaluatePlanPolicies(ctx, w.typeName, response.Plan.Raw, w.meta)...)
+}
+
+// checkPreventDestroy checks any configured prevent_destroy rules against a resource that the plan destroys or replaces.
+func checkPreventDestroy(typeName string, prior, planned tftypes.Value, requiresReplace bool, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Nothing is destroyed when a resource is created.
+	if meta == nil || len(meta.PreventDestroyRules) == 0 || prior.IsNull() {
+		return diags
+	}
+
+	if !planned.IsNull() && !requiresReplace {
+		return diags
+	}
+
+	if err := meta.PreventDestroyRules.Check(preventdestroy.ResourceFromTerraform(typeName, prior)); err != nil {
+		diags.AddError("Destroy prevented", err.Error())
+	}
+
+	return diags
+}
+
+// evaluatePlanPolicies evaluates any configured plan policies against a resource's planned values.
+func evaluatePlanPolicies(ctx context.Context, typeName string, planned tftypes.Value, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || len(meta.PlanPolicies) == 0 || planned.IsNull() {
+		return diags
+	}
+
+	v, err := planpolicy.FromTerraform(planned)
+	if err != nil {
+		diags.AddError("Evaluating plan policies", err.Error())
+		return diags
 	}
+
+	for _, v := range meta.PlanPolicies.Evaluate(ctx, typeName, v) {
+		switch v.Enforcement {
+		case planpolicy.EnforcementWarning:
+			diags.AddWarning("Plan policy violation", v.String())
+		default:
+			diags.AddError("Plan policy violation", v.String())
+		}
+	}
+
+	return diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -380,6 +445,37 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	return nil
 }
 
+// preventDestroyResourceInterceptor prevents resources matching the provider's prevent_destroy rules from being deleted.
+// Plans that destroy or replace such a resource already fail in ModifyPlan, so this is a backstop
+// for deletes that weren't planned with the same rules.
+type preventDestroyResourceInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || meta == nil || len(meta.PreventDestroyRules) == 0 {
+		return ctx, diags
+	}
+
+	if err := meta.PreventDestroyRules.Check(preventdestroy.ResourceFromTerraform(r.typeName, request.State.Raw)); err != nil {
+		diags.AddError("Destroy prevented", err.Error())
+	}
+
+	return ctx, diags
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
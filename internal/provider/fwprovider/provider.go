@@ -92,6 +92,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"plan_time_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to validate resource arguments during planning using AWS service validation APIs, e.g. Step Functions `ValidateStateMachineDefinition`. Validation makes AWS API calls, and needs the corresponding IAM permissions, during planning.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -288,6 +292,43 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"plan_policy": schema.ListNestedBlock{
+				Description: "Configuration block with a policy to evaluate against the planned values of every managed resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether a policy violation is reported as an error (`error`, the default) or a warning (`warning`).",
+						},
+						"path": schema.StringAttribute{
+							Required:    true,
+							Description: "Path to a file containing a CEL policy expression.",
+						},
+					},
+				},
+			},
+			"prevent_destroy": schema.ListNestedBlock{
+				Description: "Configuration block with a rule that prevents matching resources from being destroyed or replaced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Shell patterns matched against the resource's name, or its ID if it has no name.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type names or shell patterns matched against the resource type name.",
+						},
+						names.AttrTags: schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags that the resource must have, with the specified values.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -412,7 +453,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				preventDestroyResourceInterceptor{typeName: typeName},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -443,7 +486,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, typeName)
 			})
 		}
 	}
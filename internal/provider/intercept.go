@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -195,6 +196,31 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// preventDestroyInterceptor prevents resources matching the provider's prevent_destroy rules from being deleted.
+// Plans that destroy or replace such a resource already fail in the plan server's PlanResourceChange,
+// so this is a backstop for deletes that weren't planned with the same rules.
+type preventDestroyInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || len(c.PreventDestroyRules) == 0 {
+		return ctx, diags
+	}
+
+	resource := preventdestroy.ResourceFromCty(r.typeName, d.GetRawState())
+	if resource.ID == "" {
+		resource.ID = d.Id()
+	}
+
+	if err := c.PreventDestroyRules.Check(resource); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
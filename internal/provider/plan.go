@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/plandiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
+)
+
+// planServer is a protocol version 5 provider server that checks the planned changes of Plugin SDK resources
+// against the provider's prevent_destroy rules and plan policies, and returns the warnings added by CustomizeDiff functions.
+// CustomizeDiff isn't called when a resource is destroyed and can only fail a plan,
+// whereas PlanResourceChange is called for every planned change and its responses can also contain warnings.
+// The server enables the PlanDestroy capability so that destroy plans are sent to it.
+// Plugin Framework resources are checked in their ModifyPlan wrapper.
+type planServer struct {
+	tfprotov5.ProviderServer
+	meta       func() *conns.AWSClient
+	stateTypes stateTypeCache
+}
+
+func newPlanServer(server tfprotov5.ProviderServer, meta func() *conns.AWSClient) *planServer {
+	return &planServer{
+		ProviderServer: server,
+		meta:           meta,
+	}
+}
+
+func (s *planServer) GetMetadata(ctx context.Context, request *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	response, err := s.ProviderServer.GetMetadata(ctx, request)
+
+	if response != nil {
+		response.ServerCapabilities = withPlanDestroy(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *planServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := s.ProviderServer.GetProviderSchema(ctx, request)
+
+	if response != nil {
+		response.ServerCapabilities = withPlanDestroy(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *planServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx = plandiag.NewContext(ctx)
+
+	var response *tfprotov5.PlanResourceChangeResponse
+	var err error
+
+	if request.ProposedNewState == nil {
+		// A destroy plan, which the Plugin SDK can't decode.
+		response = &tfprotov5.PlanResourceChangeResponse{
+			PlannedPrivate: request.PriorPrivate,
+		}
+	} else {
+		response, err = s.ProviderServer.PlanResourceChange(ctx, request)
+	}
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	// Warnings added by CustomizeDiff functions.
+	response.Diagnostics = append(response.Diagnostics, plandiag.Warnings(ctx)...)
+
+	if hasErrorDiagnostic(response.Diagnostics) {
+		return response, nil
+	}
+
+	meta := s.meta()
+
+	if meta == nil {
+		return response, nil
+	}
+
+	if len(meta.PreventDestroyRules) > 0 {
+		if err := s.checkPreventDestroy(ctx, request, response, meta.PreventDestroyRules); err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Destroy prevented",
+				Detail:   err.Error(),
+			})
+
+			return response, nil
+		}
+	}
+
+	if len(meta.PlanPolicies) > 0 {
+		diags, err := s.evaluatePlanPolicies(ctx, request.TypeName, response.PlannedState, meta.PlanPolicies)
+
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Evaluating plan policies",
+				Detail:   err.Error(),
+			})
+
+			return response, nil
+		}
+
+		response.Diagnostics = append(response.Diagnostics, diags...)
+	}
+
+	return response, nil
+}
+
+// checkPreventDestroy returns an error if the planned change destroys a resource that matches the specified prevent_destroy rules.
+// A replacement destroys the resource, so it is also prevented.
+func (s *planServer) checkPreventDestroy(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse, rules preventdestroy.Rules) error {
+	if request.PriorState == nil {
+		return nil
+	}
+
+	stateType, err := s.stateTypes.get(ctx, s.ProviderServer, request.TypeName)
+
+	if err != nil {
+		return err
+	}
+
+	prior, err := request.PriorState.Unmarshal(stateType)
+
+	if err != nil {
+		return fmt.Errorf("decoding prior state: %w", err)
+	}
+
+	// Nothing is destroyed when a resource is created.
+	if prior.IsNull() {
+		return nil
+	}
+
+	if len(response.RequiresReplace) == 0 && response.PlannedState != nil {
+		planned, err := response.PlannedState.Unmarshal(stateType)
+
+		if err != nil {
+			return fmt.Errorf("decoding planned state: %w", err)
+		}
+
+		if !planned.IsNull() {
+			return nil
+		}
+	}
+
+	return rules.Check(preventdestroy.ResourceFromTerraform(request.TypeName, prior))
+}
+
+// evaluatePlanPolicies evaluates the specified plan policies against a resource's planned values.
+// Violations of policies with `warning` enforcement are returned as warnings and all others as errors.
+func (s *planServer) evaluatePlanPolicies(ctx context.Context, typeName string, plannedState *tfprotov5.DynamicValue, policies planpolicy.Policies) ([]*tfprotov5.Diagnostic, error) {
+	if plannedState == nil {
+		return nil, nil
+	}
+
+	stateType, err := s.stateTypes.get(ctx, s.ProviderServer, typeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := plannedState.Unmarshal(stateType)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding planned state: %w", err)
+	}
+
+	if planned.IsNull() {
+		return nil, nil
+	}
+
+	v, err := planpolicy.FromTerraform(planned)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var diags []*tfprotov5.Diagnostic
+
+	for _, v := range policies.Evaluate(ctx, typeName, v) {
+		severity := tfprotov5.DiagnosticSeverityError
+		if v.Enforcement == planpolicy.EnforcementWarning {
+			severity = tfprotov5.DiagnosticSeverityWarning
+		}
+
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  "Plan policy violation",
+			Detail:   v.String(),
+		})
+	}
+
+	return diags, nil
+}
+
+// withPlanDestroy returns a copy of the server capabilities with PlanDestroy enabled.
+func withPlanDestroy(capabilities *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	var v tfprotov5.ServerCapabilities
+
+	if capabilities != nil {
+		v = *capabilities
+	}
+
+	v.PlanDestroy = true
+
+	return &v
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, v := range diags {
+		if v != nil && v.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/plandiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
+)
+
+// testPlanProviderServer is a provider server with a single resource type, "aws_test",
+// that plans the proposed new state.
+type testPlanProviderServer struct {
+	tfprotov5.ProviderServer
+	diags           []*tfprotov5.Diagnostic
+	requiresReplace []*tftypes.AttributePath
+	warning         string
+}
+
+var testPlanStateType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	},
+}
+
+func (s *testPlanProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_test": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "id", Type: tftypes.String, Computed: true},
+						{Name: "name", Type: tftypes.String, Optional: true},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *testPlanProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if s.warning != "" {
+		plandiag.AddWarning(ctx, s.warning, "")
+	}
+
+	return &tfprotov5.PlanResourceChangeResponse{
+		PlannedState:    request.ProposedNewState,
+		RequiresReplace: s.requiresReplace,
+		Diagnostics:     s.diags,
+	}, nil
+}
+
+func testPlanDynamicValue(t *testing.T, name *string) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	v := tftypes.NewValue(testPlanStateType, nil)
+	if name != nil {
+		v = tftypes.NewValue(testPlanStateType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, *name),
+		})
+	}
+
+	dv, err := tfprotov5.NewDynamicValue(testPlanStateType, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &dv
+}
+
+func TestPlanServerPlanPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	for name, source := range map[string]string{
+		"error.cel":   `planned.name != "forbidden" ? "" : "name is forbidden"`,
+		"warning.cel": `planned.name != "discouraged" ? "" : "name is discouraged"`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	policies, err := planpolicy.Load([]planpolicy.Config{
+		{Path: filepath.Join(dir, "error.cel")},
+		{Path: filepath.Join(dir, "warning.cel"), Enforcement: planpolicy.EnforcementWarning},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ptr := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		meta          *conns.AWSClient
+		name          *string
+		diags         []*tfprotov5.Diagnostic
+		expectedDiags []*tfprotov5.Diagnostic
+	}{
+		"no policies": {
+			meta: &conns.AWSClient{},
+			name: ptr("forbidden"),
+		},
+		"compliant": {
+			meta: &conns.AWSClient{PlanPolicies: policies},
+			name: ptr("allowed"),
+		},
+		"error": {
+			meta: &conns.AWSClient{PlanPolicies: policies},
+			name: ptr("forbidden"),
+			expectedDiags: []*tfprotov5.Diagnostic{
+				{Severity: tfprotov5.DiagnosticSeverityError, Summary: "Plan policy violation", Detail: "error.cel: name is forbidden"},
+			},
+		},
+		"warning": {
+			meta: &conns.AWSClient{PlanPolicies: policies},
+			name: ptr("discouraged"),
+			expectedDiags: []*tfprotov5.Diagnostic{
+				{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "Plan policy violation", Detail: "warning.cel: name is discouraged"},
+			},
+		},
+		"destroy": {
+			meta: &conns.AWSClient{PlanPolicies: policies},
+		},
+		"plan error": {
+			meta: &conns.AWSClient{PlanPolicies: policies},
+			name: ptr("forbidden"),
+			diags: []*tfprotov5.Diagnostic{
+				{Severity: tfprotov5.DiagnosticSeverityError, Summary: "Invalid plan"},
+			},
+			expectedDiags: []*tfprotov5.Diagnostic{
+				{Severity: tfprotov5.DiagnosticSeverityError, Summary: "Invalid plan"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newPlanServer(&testPlanProviderServer{diags: testCase.diags}, func() *conns.AWSClient {
+				return testCase.meta
+			})
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				ProposedNewState: testPlanDynamicValue(t, testCase.name),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanServerPreventDestroy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		PreventDestroyRules: preventdestroy.Rules{
+			{ResourceTypes: []string{"aws_test"}, NamePatterns: []string{"prod-*"}},
+		},
+	}
+
+	ptr := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		prior           *string
+		proposed        *string
+		requiresReplace bool
+		expectError     bool
+	}{
+		"create": {
+			proposed: ptr("prod-db"),
+		},
+		"update": {
+			prior:    ptr("prod-db"),
+			proposed: ptr("prod-db"),
+		},
+		"destroy": {
+			prior:       ptr("prod-db"),
+			expectError: true,
+		},
+		"replace": {
+			prior:           ptr("prod-db"),
+			proposed:        ptr("prod-db"),
+			requiresReplace: true,
+			expectError:     true,
+		},
+		"destroy unprotected": {
+			prior: ptr("test-db"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requiresReplace []*tftypes.AttributePath
+			if testCase.requiresReplace {
+				requiresReplace = append(requiresReplace, tftypes.NewAttributePath().WithAttributeName("name"))
+			}
+
+			server := newPlanServer(&testPlanProviderServer{requiresReplace: requiresReplace}, func() *conns.AWSClient {
+				return meta
+			})
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				PriorState:       testPlanDynamicValue(t, testCase.prior),
+				ProposedNewState: testPlanDynamicValue(t, testCase.proposed),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := hasErrorDiagnostic(response.Diagnostics), testCase.expectError; got != want {
+				t.Errorf("error diagnostic = %t, want = %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPlanServerWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	name := "test"
+
+	server := newPlanServer(&testPlanProviderServer{warning: "Deprecated definition"}, func() *conns.AWSClient {
+		return &conns.AWSClient{}
+	})
+
+	response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "aws_test",
+		ProposedNewState: testPlanDynamicValue(t, &name),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []*tfprotov5.Diagnostic{
+		{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "Deprecated definition"},
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, want); diff != "" {
+		t.Errorf("unexpected diagnostics diff (+wanted, -got): %s", diff)
+	}
+}
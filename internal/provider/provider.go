@@ -21,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/preventdestroy"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -146,6 +149,58 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"plan_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with a policy to evaluate against the planned values of every managed resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "Whether a policy violation is reported as an error (`error`, the default) or a warning (`warning`).",
+							ValidateDiagFunc: enum.Validate[planpolicy.Enforcement](),
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to a file containing a CEL policy expression.",
+						},
+					},
+				},
+			},
+			"plan_time_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to validate resource arguments during planning using AWS service validation APIs, e.g. Step Functions `ValidateStateMachineDefinition`. Validation makes AWS API calls, and needs the corresponding IAM permissions, during planning.",
+			},
+			"prevent_destroy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with a rule that prevents matching resources from being destroyed or replaced.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Shell patterns matched against the resource's name, or its ID if it has no name.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource type names or shell patterns matched against the resource type name.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags that the resource must have, with the specified values.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -373,7 +428,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when: Before,
+					why:  Delete,
+					interceptor: preventDestroyInterceptor{
+						typeName: typeName,
+					},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -483,6 +546,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PlanTimeValidation:             d.Get("plan_time_validation").(bool),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -567,6 +631,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("plan_policy"); ok && len(v.([]interface{})) > 0 {
+		policies, err := planpolicy.Load(expandPlanPolicies(v.([]interface{})))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.PlanPolicies = policies
+	}
+
+	if v, ok := d.GetOk("prevent_destroy"); ok && len(v.([]interface{})) > 0 {
+		rules := expandPreventDestroyRules(v.([]interface{}))
+		for i, rule := range rules {
+			if err := rule.Validate(); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "prevent_destroy rule %d: %s", i+1, err)
+			}
+		}
+		config.PreventDestroyRules = rules
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -603,6 +685,55 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func expandPlanPolicies(tfList []interface{}) []planpolicy.Config {
+	var apiObjects []planpolicy.Config
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := planpolicy.Config{
+			Enforcement: planpolicy.Enforcement(tfMap["enforcement"].(string)),
+			Path:        tfMap["path"].(string),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPreventDestroyRules(tfList []interface{}) preventdestroy.Rules {
+	var apiObjects preventdestroy.Rules
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := preventdestroy.Rule{}
+
+		if v, ok := tfMap["name_patterns"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.NamePatterns = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Tags = flex.ExpandStringValueMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
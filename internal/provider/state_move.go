@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// stateMoveServer is a protocol version 5 provider server that moves resource state between the resource types
+// of the state movers registered by service packages.
+// Terraform calls MoveResourceState for `moved` blocks whose source and target resource types differ.
+// All other calls, and moves without a registered state mover, are delegated to the wrapped server.
+type stateMoveServer struct {
+	tfprotov5.ProviderServer
+	movers     map[stateMoveKey]*types.ServicePackageStateMover
+	stateTypes stateTypeCache
+}
+
+type stateMoveKey struct {
+	source, target string
+}
+
+func newStateMoveServer(server tfprotov5.ProviderServer, stateMovers []*types.ServicePackageStateMover) *stateMoveServer {
+	movers := make(map[stateMoveKey]*types.ServicePackageStateMover, len(stateMovers))
+
+	for _, v := range stateMovers {
+		movers[stateMoveKey{source: v.SourceTypeName, target: v.TargetTypeName}] = v
+	}
+
+	return &stateMoveServer{
+		ProviderServer: server,
+		movers:         movers,
+	}
+}
+
+// stateMovers returns the state movers registered by all service packages.
+func stateMovers(ctx context.Context) []*types.ServicePackageStateMover {
+	var stateMovers []*types.ServicePackageStateMover
+
+	for _, sp := range servicePackages(ctx) {
+		if v, ok := sp.(interface {
+			StateMovers(context.Context) []*types.ServicePackageStateMover
+		}); ok {
+			stateMovers = append(stateMovers, v.StateMovers(ctx)...)
+		}
+	}
+
+	return stateMovers
+}
+
+func (s *stateMoveServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	mover, ok := s.movers[stateMoveKey{source: request.SourceTypeName, target: request.TargetTypeName}]
+
+	if !ok || !isAWSProviderAddress(request.SourceProviderAddress) {
+		return s.ProviderServer.MoveResourceState(ctx, request)
+	}
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+
+	targetState, err := s.moveResourceState(ctx, mover, request)
+
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unable to Move Resource State",
+			Detail:   fmt.Sprintf("moving resource state from %s to %s: %s", request.SourceTypeName, request.TargetTypeName, err),
+		})
+
+		return response, nil
+	}
+
+	response.TargetState = targetState
+
+	return response, nil
+}
+
+func (s *stateMoveServer) moveResourceState(ctx context.Context, mover *types.ServicePackageStateMover, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.DynamicValue, error) {
+	if request.SourceState == nil || len(request.SourceState.JSON) == 0 {
+		return nil, fmt.Errorf("source state is empty")
+	}
+
+	stateType, err := s.stateTypes.get(ctx, s.ProviderServer, request.TargetTypeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var source map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(request.SourceState.JSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&source); err != nil {
+		return nil, fmt.Errorf("decoding source state: %w", err)
+	}
+
+	target, err := mover.Move(ctx, request.SourceSchemaVersion, source)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(target)
+
+	if err != nil {
+		return nil, fmt.Errorf("encoding target state: %w", err)
+	}
+
+	// Attributes that the target resource type doesn't have are dropped.
+	v, err := (&tfprotov5.RawState{JSON: b}).UnmarshalWithOpts(stateType, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding target state: %w", err)
+	}
+
+	targetState, err := tfprotov5.NewDynamicValue(stateType, v)
+
+	if err != nil {
+		return nil, fmt.Errorf("encoding target state: %w", err)
+	}
+
+	return &targetState, nil
+}
+
+// stateTypeCache caches the state types of a provider server's resource types.
+type stateTypeCache struct {
+	mu    sync.Mutex
+	types map[string]tftypes.Type
+}
+
+// get returns the state type of the specified resource type.
+func (c *stateTypeCache) get(ctx context.Context, server tfprotov5.ProviderServer, typeName string) (tftypes.Type, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := c.types[typeName]; ok {
+		return v, nil
+	}
+
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := response.ResourceSchemas[typeName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type %s not found", typeName)
+	}
+
+	if c.types == nil {
+		c.types = make(map[string]tftypes.Type)
+	}
+
+	v := schema.ValueType()
+	c.types[typeName] = v
+
+	return v, nil
+}
+
+// isAWSProviderAddress returns whether the specified provider source address is that of this provider,
+// e.g. "registry.terraform.io/hashicorp/aws".
+func isAWSProviderAddress(address string) bool {
+	return strings.HasSuffix(address, "/hashicorp/aws")
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// testStateMoveProviderServer is a provider server with a single resource type, "aws_test_target".
+type testStateMoveProviderServer struct {
+	tfprotov5.ProviderServer
+	delegated bool
+}
+
+func (s *testStateMoveProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_test_target": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "id", Type: tftypes.String, Computed: true},
+						{Name: "count", Type: tftypes.Number, Optional: true},
+						{Name: "new_attribute", Type: tftypes.String, Optional: true},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *testStateMoveProviderServer) MoveResourceState(context.Context, *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	s.delegated = true
+
+	return &tfprotov5.MoveResourceStateResponse{}, nil
+}
+
+func TestStateMoveServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateMovers := []*types.ServicePackageStateMover{
+		{
+			SourceTypeName: "aws_test_source",
+			TargetTypeName: "aws_test_target",
+			Move: func(_ context.Context, sourceSchemaVersion int64, source map[string]any) (map[string]any, error) {
+				if sourceSchemaVersion != 0 {
+					return nil, errors.New("unsupported schema version")
+				}
+				return source, nil
+			},
+		},
+	}
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":            tftypes.String,
+			"count":         tftypes.Number,
+			"new_attribute": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		request           *tfprotov5.MoveResourceStateRequest
+		expectDelegated   bool
+		expectError       bool
+		expectTargetState map[string]tftypes.Value
+	}{
+		"moved": {
+			request: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceTypeName:        "aws_test_source",
+				SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"test","count":12345678901234567890,"old_attribute":"removed"}`)},
+				TargetTypeName:        "aws_test_target",
+			},
+			expectTargetState: map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "test"),
+				"count":         tftypes.NewValue(tftypes.Number, testBigFloat(t, "12345678901234567890")),
+				"new_attribute": tftypes.NewValue(tftypes.String, nil),
+			},
+		},
+		"move error": {
+			request: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "aws_test_source",
+				SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"test"}`)},
+				TargetTypeName:        "aws_test_target",
+			},
+			expectError: true,
+		},
+		"other provider": {
+			request: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+				SourceTypeName:        "aws_test_source",
+				SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"test"}`)},
+				TargetTypeName:        "aws_test_target",
+			},
+			expectDelegated: true,
+		},
+		"no state mover": {
+			request: &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceTypeName:        "aws_test_other",
+				SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"test"}`)},
+				TargetTypeName:        "aws_test_target",
+			},
+			expectDelegated: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &testStateMoveProviderServer{}
+			server := newStateMoveServer(inner, stateMovers)
+
+			response, err := server.MoveResourceState(ctx, testCase.request)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := inner.delegated, testCase.expectDelegated; got != want {
+				t.Errorf("delegated = %t, want %t", got, want)
+			}
+
+			if got, want := len(response.Diagnostics) > 0, testCase.expectError; got != want {
+				t.Errorf("error diagnostics = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.expectTargetState == nil {
+				return
+			}
+
+			if response.TargetState == nil {
+				t.Fatal("expected target state")
+			}
+
+			v, err := response.TargetState.Unmarshal(stateType)
+
+			if err != nil {
+				t.Fatalf("unmarshaling target state: %s", err)
+			}
+
+			if expected := tftypes.NewValue(stateType, testCase.expectTargetState); !v.Equal(expected) {
+				t.Errorf("target state = %s, want %s", v, expected)
+			}
+		})
+	}
+}
+
+func testBigFloat(t *testing.T, s string) *big.Float {
+	t.Helper()
+
+	v, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
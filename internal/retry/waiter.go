@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// defaultWaiterOptions mirror the Plugin SDK v2 StateChangeConf's backoff: starting at 100ms, doubling, up to 10s.
+var defaultWaiterOptions = Options{
+	BackoffMinDuration: 100 * time.Millisecond,
+	BackoffMultiplier:  2,
+	BackoffMaxDuration: 10 * time.Second,
+}
+
+const (
+	// Number of consecutive "not found" results tolerated while waiting for a target status.
+	defaultNotFoundChecks = 20
+)
+
+type waiter[T any, S ~string] struct {
+	find                      OpFunc[T]
+	status                    func(T) S
+	failureReason             func(T) error
+	pending, target, failure  []S
+	delay                     time.Duration
+	options                   Options
+	notFoundChecks            int
+	continuousTargetOccurence int
+}
+
+// Waiter returns a new wrapper that polls for a resource, using find, until the status extracted by status reaches a target value.
+// find must return a retry.NotFoundError (see tfresource.NotFound) if the resource doesn't exist.
+func Waiter[T any, S ~string](find OpFunc[T], status func(T) S) waiter[T, S] {
+	return waiter[T, S]{
+		find:                      find,
+		status:                    status,
+		options:                   defaultWaiterOptions,
+		notFoundChecks:            defaultNotFoundChecks,
+		continuousTargetOccurence: 1,
+	}
+}
+
+// Pending sets the statuses in which polling continues.
+// Any status that is not pending, target or failure is unexpected and stops the wait with an error.
+func (w waiter[T, S]) Pending(statuses ...S) waiter[T, S] {
+	w.pending = statuses
+	return w
+}
+
+// Target sets the statuses that complete the wait.
+// With no target statuses, the wait completes when the resource is not found.
+func (w waiter[T, S]) Target(statuses ...S) waiter[T, S] {
+	w.target = statuses
+	return w
+}
+
+// Failure sets the statuses that stop the wait with an error.
+func (w waiter[T, S]) Failure(statuses ...S) waiter[T, S] {
+	w.failure = statuses
+	return w
+}
+
+// FailureReason sets the function that extracts the reason for a failure from the resource, e.g. its status message.
+func (w waiter[T, S]) FailureReason(f func(T) error) waiter[T, S] {
+	w.failureReason = f
+	return w
+}
+
+// Delay sets the time to wait before the first poll.
+func (w waiter[T, S]) Delay(delay time.Duration) waiter[T, S] {
+	w.delay = delay
+	return w
+}
+
+// Backoff sets the backoff between polls.
+func (w waiter[T, S]) Backoff(options Options) waiter[T, S] {
+	w.options = options
+	return w
+}
+
+// PollInterval sets a fixed (jittered) interval between polls.
+func (w waiter[T, S]) PollInterval(interval time.Duration) waiter[T, S] {
+	return w.Backoff(Options{BackoffMinDuration: interval, BackoffMultiplier: 1})
+}
+
+// NotFoundChecks sets the number of consecutive "not found" results tolerated while waiting for a target status.
+func (w waiter[T, S]) NotFoundChecks(n int) waiter[T, S] {
+	w.notFoundChecks = n
+	return w
+}
+
+// ContinuousTargetOccurence sets the number of consecutive polls that must observe a target status.
+func (w waiter[T, S]) ContinuousTargetOccurence(n int) waiter[T, S] {
+	w.continuousTargetOccurence = max(n, 1)
+	return w
+}
+
+// Run polls until a target status is observed, or the timeout elapses or the context is done.
+// It returns the last resource found along with any error.
+// Errors are the Plugin SDK v2 retry package's error types, so that tfresource.NotFound, tfresource.TimedOut and tfresource.SetLastError work as with StateChangeConf:
+//   - *retry.UnexpectedStateError if a failure or unexpected status is observed, with the failure reason as LastError
+//   - *retry.TimeoutError if the timeout elapses, with the last observed status as LastState
+//   - *retry.NotFoundError if the resource isn't found more than the allowed number of times
+func (w waiter[T, S]) Run(ctx context.Context, timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		last            T
+		lastStatus      S
+		notFound        int
+		targetOccurence int
+	)
+
+	if w.delay > 0 {
+		sleep(ctx, w.delay)
+	}
+
+	for r := BeginWithOptions(w.options); r.Continue(ctx); {
+		t, err := w.find(ctx)
+
+		if tfresource.NotFound(err) {
+			var zero T
+			last, lastStatus, targetOccurence = zero, "", 0
+
+			// Waiting for the resource to be deleted.
+			if len(w.target) == 0 {
+				return zero, nil
+			}
+
+			if notFound++; notFound > w.notFoundChecks {
+				return zero, &sdkretry.NotFoundError{
+					LastError: err,
+					Retries:   notFound,
+				}
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return t, err
+		}
+
+		status := w.status(t)
+		last, lastStatus, notFound = t, status, 0
+
+		switch {
+		case slices.Contains(w.target, status):
+			if targetOccurence++; targetOccurence >= w.continuousTargetOccurence {
+				return t, nil
+			}
+
+		case slices.Contains(w.failure, status), !slices.Contains(w.pending, status):
+			// Failure and unexpected statuses.
+			var reason error
+			if w.failureReason != nil {
+				reason = w.failureReason(t)
+			}
+
+			return t, &sdkretry.UnexpectedStateError{
+				LastError:     reason,
+				State:         string(status),
+				ExpectedState: enum.Slice(w.target...),
+			}
+
+		default:
+			targetOccurence = 0
+		}
+	}
+
+	if err := ctx.Err(); !errors.Is(err, context.DeadlineExceeded) {
+		return last, err
+	}
+
+	return last, &sdkretry.TimeoutError{
+		LastState:     string(lastStatus),
+		Timeout:       timeout,
+		ExpectedState: enum.Slice(w.target...),
+	}
+}
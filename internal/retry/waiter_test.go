@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testStatus string
+
+type testResource struct {
+	status testStatus
+	reason string
+}
+
+// testFinder returns a finder that returns each of the specified resources in turn, repeating the last one.
+// A nil resource is "not found".
+func testFinder(resources ...*testResource) OpFunc[*testResource] {
+	i := 0
+	return func(context.Context) (*testResource, error) {
+		r := resources[min(i, len(resources)-1)]
+		i++
+		if r == nil {
+			return nil, &sdkretry.NotFoundError{}
+		}
+		return r, nil
+	}
+}
+
+func testWaiter(resources ...*testResource) waiter[*testResource, testStatus] {
+	return Waiter(testFinder(resources...), func(r *testResource) testStatus {
+		return r.status
+	}).PollInterval(time.Millisecond)
+}
+
+func TestWaiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	creating, active, failed := &testResource{status: "CREATING"}, &testResource{status: "ACTIVE"}, &testResource{status: "FAILED", reason: "quota exceeded"}
+
+	testCases := map[string]struct {
+		waiter        waiter[*testResource, testStatus]
+		expected      *testResource
+		expectedError string
+		check         func(error) bool
+	}{
+		"target": {
+			waiter:   testWaiter(nil, creating, creating, active).Pending("CREATING").Target("ACTIVE"),
+			expected: active,
+		},
+		"continuous target occurence": {
+			waiter:   testWaiter(creating, active, creating, active, active).Pending("CREATING").Target("ACTIVE").ContinuousTargetOccurence(2),
+			expected: active,
+		},
+		"deleted": {
+			waiter: testWaiter(active, nil).Pending("ACTIVE"),
+		},
+		"failure": {
+			waiter: testWaiter(creating, failed).Pending("CREATING").Target("ACTIVE").Failure("FAILED").FailureReason(func(r *testResource) error {
+				return errors.New(r.reason)
+			}),
+			expected:      failed,
+			expectedError: "unexpected state 'FAILED', wanted target 'ACTIVE'. last error: quota exceeded",
+		},
+		"failure also pending": {
+			waiter:        testWaiter(failed).Pending("CREATING", "FAILED").Target("ACTIVE").Failure("FAILED"),
+			expected:      failed,
+			expectedError: "unexpected state 'FAILED', wanted target 'ACTIVE'",
+		},
+		"unexpected": {
+			waiter:        testWaiter(creating, &testResource{status: "DELETING"}).Pending("CREATING").Target("ACTIVE"),
+			expected:      &testResource{status: "DELETING"},
+			expectedError: "unexpected state 'DELETING'",
+		},
+		"not found": {
+			waiter:        testWaiter(nil).Pending("CREATING").Target("ACTIVE").NotFoundChecks(2),
+			expectedError: "couldn't find resource (3 retries)",
+			check:         tfresource.NotFound,
+		},
+		"find error": {
+			waiter: Waiter(func(context.Context) (*testResource, error) {
+				return nil, errors.New("access denied")
+			}, func(r *testResource) testStatus {
+				return r.status
+			}).Target("ACTIVE"),
+			expectedError: "access denied",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.waiter.Run(ctx, 5*time.Second)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+				}
+				if testCase.check != nil && !testCase.check(err) {
+					t.Errorf("unexpected error type: %T", err)
+				}
+			}
+
+			if testCase.expected == nil {
+				if got != nil {
+					t.Errorf("expected no resource, got %v", got)
+				}
+			} else if got == nil || *got != *testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	t.Parallel()
+
+	creating := &testResource{status: "CREATING"}
+
+	got, err := testWaiter(creating).Pending("CREATING").Target("ACTIVE").Run(context.Background(), 50*time.Millisecond)
+
+	if got != creating {
+		t.Errorf("expected last observed resource, got %v", got)
+	}
+
+	if !tfresource.TimedOut(err) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+
+	if expected := "timeout while waiting for state to become 'ACTIVE' (last state: 'CREATING', timeout: 50ms)"; err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
+
+func TestWaiterContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := testWaiter(&testResource{status: "CREATING"}).Pending("CREATING").Target("ACTIVE").Run(ctx, time.Minute)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+}
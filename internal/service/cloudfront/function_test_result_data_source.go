@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudfront_function_test_result", name="Function Test Result")
+func dataSourceFunctionTestResult() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFunctionTestResultRead,
+
+		Schema: map[string]*schema.Schema{
+			"compute_utilization": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_object": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsJSON, validation.StringLenBetween(1, 40960)),
+			},
+			"function_error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_execution_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStage: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.FunctionStageDevelopment,
+				ValidateDiagFunc: enum.Validate[awstypes.FunctionStage](),
+			},
+		},
+	}
+}
+
+func dataSourceFunctionTestResultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	stage := awstypes.FunctionStage(d.Get(names.AttrStage).(string))
+	outputDF, err := findFunctionByTwoPartKey(ctx, conn, name, stage)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Function (%s) %s stage: %s", name, stage, err)
+	}
+
+	input := &cloudfront.TestFunctionInput{
+		EventObject: []byte(d.Get("event_object").(string)),
+		IfMatch:     outputDF.ETag,
+		Name:        aws.String(name),
+		Stage:       stage,
+	}
+
+	output, err := conn.TestFunction(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s) %s stage: %s", name, stage, err)
+	}
+
+	testResult := output.TestResult
+	if testResult == nil {
+		return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s) %s stage: empty result", name, stage)
+	}
+
+	d.SetId(name)
+	d.Set("compute_utilization", testResult.ComputeUtilization)
+	d.Set("etag", outputDF.ETag)
+	d.Set("function_error_message", testResult.FunctionErrorMessage)
+	d.Set("function_execution_logs", testResult.FunctionExecutionLogs)
+	d.Set("function_output", testResult.FunctionOutput)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontFunctionTestResultDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudfront_function_test_result.test"
+	resourceName := "aws_cloudfront_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionTestResultDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_utilization"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_error_message", ""),
+					resource.TestMatchResourceAttr(dataSourceName, "function_output", regexache.MustCompile(`"statusCode":302`)),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStage, "DEVELOPMENT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionTestResultDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName), `
+data "aws_cloudfront_function_test_result" "test" {
+  name = aws_cloudfront_function.test.name
+
+  event_object = jsonencode({
+    version = "1.0"
+    context = {
+      eventType = "viewer-request"
+    }
+    viewer = {
+      ip = "198.51.100.11"
+    }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = {}
+      cookies     = {}
+      querystring = {}
+    }
+  })
+}
+`)
+}
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudfront_function",
 			Name:     "Function",
 		},
+		{
+			Factory:  dataSourceFunctionTestResult,
+			TypeName: "aws_cloudfront_function_test_result",
+			Name:     "Function Test Result",
+		},
 		{
 			Factory:  dataSourceLogDeliveryCanonicalUserID,
 			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchCompositeAlarmDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_composite_alarm.test"
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "actions_enabled", resourceName, "actions_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "actions_suppressor.#", resourceName, "actions_suppressor.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_actions.#", resourceName, "alarm_actions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_description", resourceName, "alarm_description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_name", resourceName, "alarm_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_rule", resourceName, "alarm_rule"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "insufficient_data_actions.#", resourceName, "insufficient_data_actions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ok_actions.#", resourceName, "ok_actions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state_value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func testAccCompositeAlarmDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), `
+data "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = aws_cloudwatch_composite_alarm.test.alarm_name
+}
+`)
+}
@@ -0,0 +1,477 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_status": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[dashboardDocAlarmSortBy](),
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          dashboardDocLogViewTable,
+										ValidateDiagFunc: enum.Validate[dashboardDocLogView](),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrExpression: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrID: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrMetricName: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrNamespace: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[dashboardDocYAxis](),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "Average",
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          dashboardDocMetricViewTimeSeries,
+										ValidateDiagFunc: enum.Validate[dashboardDocMetricView](),
+									},
+								},
+							},
+						},
+						"new_row": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[dashboardDocTextBackground](),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dashboardDoc := &cloudWatchDashboardDoc{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        []*cloudWatchDashboardWidget{},
+	}
+
+	region := meta.(*conns.AWSClient).Region
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "widget %d: %s", i, err)
+		}
+
+		dashboardDoc.Widgets = append(dashboardDoc.Widgets, widget)
+	}
+
+	layoutDashboardWidgets(dashboardDoc.Widgets)
+
+	jsonDoc, err := json.Marshal(dashboardDoc)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Normalize the document as aws_cloudwatch_dashboard does, so that dashboard_body matches it exactly.
+	jsonString, err := structure.NormalizeJsonString(string(jsonDoc))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+// layoutDashboardWidgets places the widgets left to right in rows across the dashboard grid.
+// A widget starts a new row if it doesn't fit in the current one or if new_row is set.
+// Each row is as tall as its tallest widget.
+func layoutDashboardWidgets(widgets []*cloudWatchDashboardWidget) {
+	var x, y, rowHeight int
+
+	for _, widget := range widgets {
+		if x > 0 && (widget.newRow || x+widget.Width > dashboardGridWidth) {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		widget.X, widget.Y = x, y
+		x += widget.Width
+		rowHeight = max(rowHeight, widget.Height)
+	}
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*cloudWatchDashboardWidget, error) {
+	apiObject := &cloudWatchDashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	if v, ok := tfMap["new_row"].(bool); ok {
+		apiObject.newRow = v
+	}
+
+	var n int
+	if v, ok := tfMap["alarm_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = "alarm"
+		apiObject.Properties = expandDashboardAlarmWidgetProperties(v[0].(map[string]interface{}))
+		n++
+	}
+	if v, ok := tfMap["log_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = "log"
+		apiObject.Properties = expandDashboardLogWidgetProperties(v[0].(map[string]interface{}), region)
+		n++
+	}
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		properties, err := expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), region)
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Type = "metric"
+		apiObject.Properties = properties
+		n++
+	}
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = "text"
+		apiObject.Properties = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if n != 1 {
+		return nil, errors.New("exactly one of alarm_status, log_query, metric, or text must be configured")
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) *dashboardAlarmWidgetProperties {
+	apiObject := &dashboardAlarmWidgetProperties{
+		SortBy: tfMap["sort_by"].(string),
+		Title:  tfMap["title"].(string),
+	}
+
+	for _, v := range tfMap["alarms"].([]interface{}) {
+		if v, ok := v.(string); ok {
+			apiObject.Alarms = append(apiObject.Alarms, v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) *dashboardLogWidgetProperties {
+	apiObject := &dashboardLogWidgetProperties{
+		Region: region,
+		Title:  tfMap["title"].(string),
+		View:   tfMap["view"].(string),
+	}
+
+	if v := tfMap[names.AttrRegion].(string); v != "" {
+		apiObject.Region = v
+	}
+
+	// The log groups are part of the query, e.g. "SOURCE 'group1' | SOURCE 'group2' | fields @message".
+	var parts []string
+	for _, v := range tfMap["log_group_names"].([]interface{}) {
+		if v, ok := v.(string); ok {
+			parts = append(parts, fmt.Sprintf("SOURCE '%s'", v))
+		}
+	}
+	parts = append(parts, strings.TrimSpace(tfMap["query"].(string)))
+	apiObject.Query = strings.Join(parts, " | ")
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (*dashboardMetricWidgetProperties, error) {
+	apiObject := &dashboardMetricWidgetProperties{
+		Period:  tfMap["period"].(int),
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Stat:    tfMap["stat"].(string),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v := tfMap[names.AttrRegion].(string); v != "" {
+		apiObject.Region = v
+	}
+
+	for i, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric, err := expandDashboardMetric(tfMap)
+		if err != nil {
+			return nil, fmt.Errorf("metric %d: %w", i, err)
+		}
+
+		apiObject.Metrics = append(apiObject.Metrics, metric)
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardMetric(tfMap map[string]interface{}) (dashboardMetric, error) {
+	properties := dashboardMetricRenderingProperties{
+		Expression: tfMap[names.AttrExpression].(string),
+		ID:         tfMap[names.AttrID].(string),
+		Label:      tfMap["label"].(string),
+		Period:     tfMap["period"].(int),
+		Stat:       tfMap["stat"].(string),
+		YAxis:      tfMap["y_axis"].(string),
+	}
+
+	if !tfMap["visible"].(bool) {
+		properties.Visible = new(bool)
+	}
+
+	namespace, metricName := tfMap[names.AttrNamespace].(string), tfMap[names.AttrMetricName].(string)
+	var apiObject dashboardMetric
+
+	switch {
+	case properties.Expression != "":
+		if namespace != "" || metricName != "" {
+			return nil, errors.New("expression conflicts with namespace and metric_name")
+		}
+	case namespace != "" && metricName != "":
+		apiObject = dashboardMetric{namespace, metricName}
+
+		// Dimensions are sorted by name so that the document is stable.
+		dimensions := tfMap["dimensions"].(map[string]interface{})
+		keys := make([]string, 0, len(dimensions))
+		for k := range dimensions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			apiObject = append(apiObject, k, dimensions[k])
+		}
+	default:
+		return nil, errors.New("one of expression, or namespace and metric_name, must be configured")
+	}
+
+	if properties != (dashboardMetricRenderingProperties{}) {
+		apiObject = append(apiObject, properties)
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) *dashboardTextWidgetProperties {
+	return &dashboardTextWidgetProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
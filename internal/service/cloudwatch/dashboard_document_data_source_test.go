@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, testAccDashboardDocumentExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidWidget(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidWidget,
+				ExpectError: regexache.MustCompile(`exactly one of alarm_status, log_query, metric, or text must be configured`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", "data.aws_cloudwatch_dashboard_document.test", names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentExpectedJSON() string {
+	return `{"periodOverride":"inherit","start":"-PT6H","widgets":[` +
+		`{"height":2,"properties":{"background":"transparent","markdown":"# Service"},"type":"text","width":24,"x":0,"y":0},` +
+		`{"height":6,"properties":{"metrics":[` +
+		`["AWS/EC2","CPUUtilization","AutoScalingGroupName","example","Tier","web",{"id":"m1","visible":false}],` +
+		`[{"expression":"m1 * 2","id":"e1","label":"Doubled"}]` +
+		`],"period":60,"region":"us-west-2","stacked":true,"stat":"Maximum","title":"CPU","view":"timeSeries"},"type":"metric","width":12,"x":0,"y":2},` +
+		`{"height":4,"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"],"sortBy":"stateUpdatedTimestamp","title":"Alarms"},"type":"alarm","width":6,"x":12,"y":2},` + //lintignore:AWSAT003,AWSAT005
+		`{"height":6,"properties":{"query":"SOURCE '/aws/lambda/a' | SOURCE '/aws/lambda/b' | fields @timestamp, @message | limit 20","region":"us-west-2","title":"Logs","view":"table"},"type":"log","width":6,"x":0,"y":8}` +
+		`]}`
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      background = "transparent"
+      markdown   = "# Service"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      period  = 60
+      region  = "us-west-2"
+      stacked = true
+      stat    = "Maximum"
+      title   = "CPU"
+
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        id          = "m1"
+        visible     = false
+
+        dimensions = {
+          Tier                 = "web"
+          AutoScalingGroupName = "example"
+        }
+      }
+
+      metric {
+        expression = "m1 * 2"
+        id         = "e1"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    height = 4
+
+    alarm_status {
+      alarms  = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"] #lintignore:AWSAT003,AWSAT005
+      sort_by = "stateUpdatedTimestamp"
+      title   = "Alarms"
+    }
+  }
+
+  widget {
+    new_row = true
+
+    log_query {
+      log_group_names = ["/aws/lambda/a", "/aws/lambda/b"]
+      query           = "fields @timestamp, @message | limit 20"
+      region          = "us-west-2"
+      title           = "Logs"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_invalidWidget = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "# Service"
+    }
+
+    alarm_status {
+      alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"] #lintignore:AWSAT003,AWSAT005
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      title = "Invocations"
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        stat        = "Sum"
+      }
+    }
+  }
+
+  widget {
+    text {
+      markdown = "Hello"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
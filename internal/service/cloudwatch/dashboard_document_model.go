@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+const (
+	// Dashboards are laid out on a grid 24 units wide.
+	dashboardGridWidth = 24
+)
+
+type dashboardDocMetricView string
+
+const (
+	dashboardDocMetricViewBar         dashboardDocMetricView = "bar"
+	dashboardDocMetricViewGauge       dashboardDocMetricView = "gauge"
+	dashboardDocMetricViewPie         dashboardDocMetricView = "pie"
+	dashboardDocMetricViewSingleValue dashboardDocMetricView = "singleValue"
+	dashboardDocMetricViewTable       dashboardDocMetricView = "table"
+	dashboardDocMetricViewTimeSeries  dashboardDocMetricView = "timeSeries"
+)
+
+func (dashboardDocMetricView) Values() []dashboardDocMetricView {
+	return []dashboardDocMetricView{
+		dashboardDocMetricViewBar,
+		dashboardDocMetricViewGauge,
+		dashboardDocMetricViewPie,
+		dashboardDocMetricViewSingleValue,
+		dashboardDocMetricViewTable,
+		dashboardDocMetricViewTimeSeries,
+	}
+}
+
+type dashboardDocLogView string
+
+const (
+	dashboardDocLogViewBar        dashboardDocLogView = "bar"
+	dashboardDocLogViewPie        dashboardDocLogView = "pie"
+	dashboardDocLogViewTable      dashboardDocLogView = "table"
+	dashboardDocLogViewTimeSeries dashboardDocLogView = "timeSeries"
+)
+
+func (dashboardDocLogView) Values() []dashboardDocLogView {
+	return []dashboardDocLogView{
+		dashboardDocLogViewBar,
+		dashboardDocLogViewPie,
+		dashboardDocLogViewTable,
+		dashboardDocLogViewTimeSeries,
+	}
+}
+
+type dashboardDocAlarmSortBy string
+
+const (
+	dashboardDocAlarmSortByDefault               dashboardDocAlarmSortBy = "default"
+	dashboardDocAlarmSortByStateUpdatedTimestamp dashboardDocAlarmSortBy = "stateUpdatedTimestamp"
+	dashboardDocAlarmSortByTimestamp             dashboardDocAlarmSortBy = "timestamp"
+)
+
+func (dashboardDocAlarmSortBy) Values() []dashboardDocAlarmSortBy {
+	return []dashboardDocAlarmSortBy{
+		dashboardDocAlarmSortByDefault,
+		dashboardDocAlarmSortByStateUpdatedTimestamp,
+		dashboardDocAlarmSortByTimestamp,
+	}
+}
+
+type dashboardDocTextBackground string
+
+const (
+	dashboardDocTextBackgroundSolid       dashboardDocTextBackground = "solid"
+	dashboardDocTextBackgroundTransparent dashboardDocTextBackground = "transparent"
+)
+
+func (dashboardDocTextBackground) Values() []dashboardDocTextBackground {
+	return []dashboardDocTextBackground{
+		dashboardDocTextBackgroundSolid,
+		dashboardDocTextBackgroundTransparent,
+	}
+}
+
+type dashboardDocYAxis string
+
+const (
+	dashboardDocYAxisLeft  dashboardDocYAxis = "left"
+	dashboardDocYAxisRight dashboardDocYAxis = "right"
+)
+
+func (dashboardDocYAxis) Values() []dashboardDocYAxis {
+	return []dashboardDocYAxis{
+		dashboardDocYAxisLeft,
+		dashboardDocYAxisRight,
+	}
+}
+
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+type cloudWatchDashboardDoc struct {
+	Start          string                       `json:"start,omitempty"`
+	End            string                       `json:"end,omitempty"`
+	PeriodOverride string                       `json:"periodOverride,omitempty"`
+	Widgets        []*cloudWatchDashboardWidget `json:"widgets"`
+}
+
+type cloudWatchDashboardWidget struct {
+	Type       string      `json:"type"`
+	X          int         `json:"x"`
+	Y          int         `json:"y"`
+	Width      int         `json:"width"`
+	Height     int         `json:"height"`
+	Properties interface{} `json:"properties"`
+
+	// Whether the widget starts a new row in the layout.
+	newRow bool
+}
+
+type dashboardMetricWidgetProperties struct {
+	Metrics []dashboardMetric `json:"metrics"`
+	Period  int               `json:"period,omitempty"`
+	Region  string            `json:"region"`
+	Stacked bool              `json:"stacked,omitempty"`
+	Stat    string            `json:"stat,omitempty"`
+	Title   string            `json:"title,omitempty"`
+	View    string            `json:"view"`
+}
+
+// dashboardMetric is a metric array: namespace, metric name, dimension name and value pairs, and
+// optional rendering properties. A math expression is a metric array containing only the properties.
+type dashboardMetric []interface{}
+
+type dashboardMetricRenderingProperties struct {
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query  string `json:"query"`
+	Region string `json:"region"`
+	Title  string `json:"title,omitempty"`
+	View   string `json:"view"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_alarm", name="Metric Alarm")
+func dataSourceMetricAlarm() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricAlarmRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"alarm_actions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alarm_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alarm_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comparison_operator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datapoints_to_alarm": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"evaluate_low_sample_count_percentiles": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_periods": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"extended_statistic": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"insufficient_data_actions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrMetricName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_query": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrExpression: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrMetricName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrNamespace: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrUnit: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ok_actions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statistic": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"threshold_metric_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"treat_missing_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrUnit: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMetricAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("alarm_name").(string)
+	alarm, err := findMetricAlarmByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarm (%s): %s", name, err)
+	}
+
+	arn := aws.ToString(alarm.AlarmArn)
+	d.SetId(aws.ToString(alarm.AlarmName))
+	d.Set("actions_enabled", alarm.ActionsEnabled)
+	d.Set("alarm_actions", alarm.AlarmActions)
+	d.Set("alarm_description", alarm.AlarmDescription)
+	d.Set("alarm_name", alarm.AlarmName)
+	d.Set(names.AttrARN, arn)
+	d.Set("comparison_operator", alarm.ComparisonOperator)
+	d.Set("datapoints_to_alarm", alarm.DatapointsToAlarm)
+	if err := d.Set("dimensions", flattenMetricAlarmDimensions(alarm.Dimensions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dimensions: %s", err)
+	}
+	d.Set("evaluate_low_sample_count_percentiles", alarm.EvaluateLowSampleCountPercentile)
+	d.Set("evaluation_periods", alarm.EvaluationPeriods)
+	d.Set("extended_statistic", alarm.ExtendedStatistic)
+	d.Set("insufficient_data_actions", alarm.InsufficientDataActions)
+	d.Set(names.AttrMetricName, alarm.MetricName)
+	if err := d.Set("metric_query", flattenMetricAlarmMetrics(alarm.Metrics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_query: %s", err)
+	}
+	d.Set(names.AttrNamespace, alarm.Namespace)
+	d.Set("ok_actions", alarm.OKActions)
+	d.Set("period", alarm.Period)
+	d.Set("state_reason", alarm.StateReason)
+	if alarm.StateUpdatedTimestamp != nil {
+		d.Set("state_updated_timestamp", aws.ToTime(alarm.StateUpdatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("state_updated_timestamp", nil)
+	}
+	d.Set("state_value", alarm.StateValue)
+	d.Set("statistic", alarm.Statistic)
+	d.Set("threshold", alarm.Threshold)
+	d.Set("threshold_metric_id", alarm.ThresholdMetricId)
+	if alarm.TreatMissingData != nil { // nosemgrep: ci.helper-schema-ResourceData-Set-extraneous-nil-check
+		d.Set("treat_missing_data", alarm.TreatMissingData)
+	} else {
+		d.Set("treat_missing_data", missingDataMissing)
+	}
+	d.Set(names.AttrUnit, alarm.Unit)
+
+	tags, err := listTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for CloudWatch Metric Alarm (%s): %s", arn, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricAlarmDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_metric_alarm.test"
+	resourceName := "aws_cloudwatch_metric_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "actions_enabled", resourceName, "actions_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_description", resourceName, "alarm_description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alarm_name", resourceName, "alarm_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "comparison_operator", resourceName, "comparison_operator"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dimensions.%", resourceName, "dimensions.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dimensions.InstanceId", resourceName, "dimensions.InstanceId"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "evaluation_periods", resourceName, "evaluation_periods"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrMetricName, resourceName, names.AttrMetricName),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_query.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrNamespace, resourceName, names.AttrNamespace),
+					resource.TestCheckResourceAttrPair(dataSourceName, "period", resourceName, "period"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state_reason"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state_updated_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state_value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "statistic", resourceName, "statistic"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "threshold", resourceName, "threshold"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "treat_missing_data", resourceName, "treat_missing_data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricAlarmDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricAlarmConfig_basic(rName), `
+data "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name = aws_cloudwatch_metric_alarm.test.alarm_name
+}
+`)
+}
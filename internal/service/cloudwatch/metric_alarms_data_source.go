@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_alarms", name="Metric Alarms")
+func dataSourceMetricAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"alarm_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"alarm_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StateValue](),
+			},
+		},
+	}
+}
+
+func dataSourceMetricAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm},
+	}
+
+	if v, ok := d.GetOk("action_prefix"); ok {
+		input.ActionPrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("alarm_name_prefix"); ok {
+		input.AlarmNamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_value"); ok {
+		input.StateValue = types.StateValue(v.(string))
+	}
+
+	alarms, err := findMetricAlarms(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarms: %s", err)
+	}
+
+	var alarmARNs, alarmNames []string
+
+	for _, v := range alarms {
+		alarmARNs = append(alarmARNs, aws.ToString(v.AlarmArn))
+		alarmNames = append(alarmNames, aws.ToString(v.AlarmName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("alarm_names", alarmNames)
+	d.Set(names.AttrARNs, alarmARNs)
+
+	return diags
+}
+
+func findMetricAlarms(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeAlarmsInput) ([]types.MetricAlarm, error) {
+	var output []types.MetricAlarm
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MetricAlarms...)
+	}
+
+	return output, nil
+}
@@ -23,7 +23,28 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCompositeAlarm,
+			TypeName: "aws_cloudwatch_composite_alarm",
+			Name:     "Composite Alarm",
+		},
+		{
+			Factory:  dataSourceDashboardDocument,
+			TypeName: "aws_cloudwatch_dashboard_document",
+			Name:     "Dashboard Document",
+		},
+		{
+			Factory:  dataSourceMetricAlarm,
+			TypeName: "aws_cloudwatch_metric_alarm",
+			Name:     "Metric Alarm",
+		},
+		{
+			Factory:  dataSourceMetricAlarms,
+			TypeName: "aws_cloudwatch_metric_alarms",
+			Name:     "Metric Alarms",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -37,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_dynamodb_table_item",
 			Name:     "Table Item",
 		},
+		{
+			Factory:  dataSourceTables,
+			TypeName: "aws_dynamodb_tables",
+			Name:     "Tables",
+		},
 	}
 }
 
@@ -70,11 +75,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_table_export",
 			Name:     "Table Export",
 		},
+		{
+			Factory:  resourceTableImport,
+			TypeName: "aws_dynamodb_table_import",
+			Name:     "Table Import",
+		},
 		{
 			Factory:  resourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
 			Name:     "Table Item",
 		},
+		{
+			Factory:  resourceTableItems,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+		},
 		{
 			Factory:  resourceTableReplica,
 			TypeName: "aws_dynamodb_table_replica",
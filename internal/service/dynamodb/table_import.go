@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_table_import", name="Table Import")
+func resourceTableImport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableImportCreate,
+		ReadWithoutTimeout:   resourceTableImportRead,
+		DeleteWithoutTimeout: resourceTableImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(createTableTimeout),
+			Delete: schema.DefaultTimeout(deleteTableTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attribute": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ScalarAttributeType](),
+						},
+					},
+				},
+			},
+			"billing_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.BillingModePayPerRequest,
+				ValidateDiagFunc: enum.Validate[awstypes.BillingMode](),
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_compression_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InputCompressionType](),
+			},
+			"input_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InputFormat](),
+			},
+			"input_format_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delimiter": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"header_list": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processed_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processed_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"read_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"s3_bucket_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_owner": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTableName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"write_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	input := expandImportTable(map[string]interface{}{
+		"input_compression_type": d.Get("input_compression_type"),
+		"input_format":           d.Get("input_format"),
+		"input_format_options":   d.Get("input_format_options"),
+		"s3_bucket_source":       d.Get("s3_bucket_source"),
+	})
+
+	billingMode := awstypes.BillingMode(d.Get("billing_mode").(string))
+	input.TableCreationParameters = &awstypes.TableCreationParameters{
+		AttributeDefinitions: expandAttributes(d.Get("attribute").(*schema.Set).List()),
+		BillingMode:          billingMode,
+		KeySchema: expandKeySchema(map[string]interface{}{
+			"hash_key":  d.Get("hash_key"),
+			"range_key": d.Get("range_key"),
+		}),
+		ProvisionedThroughput: expandProvisionedThroughput(map[string]interface{}{
+			"read_capacity":  d.Get("read_capacity"),
+			"write_capacity": d.Get("write_capacity"),
+		}, billingMode),
+		TableName: aws.String(tableName),
+	}
+
+	outputRaw, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
+		return conn.ImportTable(ctx, input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
+			return true, err
+		}
+		if errs.IsAErrorMessageContains[*awstypes.LimitExceededException](err, "can be created, updated, or deleted simultaneously") {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn))
+
+	if _, err := waitImportComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Import (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableImportRead(ctx, d, meta)...)
+}
+
+func resourceTableImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	desc, err := findImportByARN(ctx, conn, d.Id())
+
+	switch {
+	case !d.IsNewResource() && tfresource.NotFound(err):
+		// Import descriptions expire 90 days after the import, but the imported table remains.
+		// The import's attributes already in state are kept.
+		if d.Get(names.AttrTableName).(string) == "" {
+			log.Printf("[WARN] DynamoDB Table Import (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+
+		log.Printf("[DEBUG] DynamoDB Table Import (%s) has expired", d.Id())
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Import (%s): %s", d.Id(), err)
+	default:
+		if err := setTableImportDescription(d, desc); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	tableName := d.Get(names.AttrTableName).(string)
+	_, err = findTableByName(ctx, conn, tableName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table (%s) imported by DynamoDB Table Import (%s) not found, removing from state", tableName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	return diags
+}
+
+func resourceTableImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	// The import itself cannot be deleted; remove the table that it created.
+	tableName := d.Get(names.AttrTableName).(string)
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table: %s", tableName)
+	_, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+		TableName: aws.String(tableName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	if _, err := waitTableDeleted(ctx, conn, tableName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table (%s) delete: %s", tableName, err)
+	}
+
+	return diags
+}
+
+func setTableImportDescription(d *schema.ResourceData, desc *awstypes.ImportTableDescription) error {
+	d.Set(names.AttrARN, desc.ImportArn)
+	if desc.EndTime != nil {
+		d.Set("end_time", aws.ToTime(desc.EndTime).Format(time.RFC3339))
+	}
+	d.Set("error_count", desc.ErrorCount)
+	d.Set("failure_code", desc.FailureCode)
+	d.Set("failure_message", desc.FailureMessage)
+	d.Set("import_status", desc.ImportStatus)
+	d.Set("imported_item_count", desc.ImportedItemCount)
+	d.Set("input_compression_type", desc.InputCompressionType)
+	d.Set("input_format", desc.InputFormat)
+	if v := desc.InputFormatOptions; v != nil && v.Csv != nil {
+		if err := d.Set("input_format_options", flattenInputFormatOptions(v)); err != nil {
+			return fmt.Errorf("setting input_format_options: %w", err)
+		}
+	} else {
+		d.Set("input_format_options", nil)
+	}
+	d.Set("processed_item_count", desc.ProcessedItemCount)
+	d.Set("processed_size_bytes", desc.ProcessedSizeBytes)
+	if v := desc.S3BucketSource; v != nil {
+		if err := d.Set("s3_bucket_source", []interface{}{flattenS3BucketSource(v)}); err != nil {
+			return fmt.Errorf("setting s3_bucket_source: %w", err)
+		}
+	} else {
+		d.Set("s3_bucket_source", nil)
+	}
+	if desc.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.ToTime(desc.StartTime).Format(time.RFC3339))
+	}
+	d.Set("table_arn", desc.TableArn)
+	if tcp := desc.TableCreationParameters; tcp != nil {
+		if err := d.Set("attribute", flattenTableAttributeDefinitions(tcp.AttributeDefinitions)); err != nil {
+			return fmt.Errorf("setting attribute: %w", err)
+		}
+		d.Set("billing_mode", tcp.BillingMode)
+		d.Set("hash_key", nil)
+		d.Set("range_key", nil)
+		for _, v := range tcp.KeySchema {
+			switch v.KeyType {
+			case awstypes.KeyTypeHash:
+				d.Set("hash_key", v.AttributeName)
+			case awstypes.KeyTypeRange:
+				d.Set("range_key", v.AttributeName)
+			}
+		}
+		if v := tcp.ProvisionedThroughput; v != nil {
+			d.Set("read_capacity", v.ReadCapacityUnits)
+			d.Set("write_capacity", v.WriteCapacityUnits)
+		}
+		d.Set(names.AttrTableName, tcp.TableName)
+	}
+
+	return nil
+}
+
+func flattenInputFormatOptions(apiObject *awstypes.InputFormatOptions) []interface{} {
+	if apiObject == nil || apiObject.Csv == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"delimiter":   aws.ToString(apiObject.Csv.Delimiter),
+		"header_list": apiObject.Csv.HeaderList,
+	}
+
+	return []interface{}{map[string]interface{}{
+		"csv": []interface{}{tfMap},
+	}}
+}
+
+func flattenS3BucketSource(apiObject *awstypes.S3BucketSource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		names.AttrBucket: aws.ToString(apiObject.S3Bucket),
+		"bucket_owner":   aws.ToString(apiObject.S3BucketOwner),
+		"key_prefix":     aws.ToString(apiObject.S3KeyPrefix),
+	}
+}
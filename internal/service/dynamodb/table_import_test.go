@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.ImportTableDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableImportExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", rName),
+					resource.TestCheckResourceAttr(resourceName, "import_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "imported_item_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "input_format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_source.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.0.key_prefix", "data"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "table_arn", "dynamodb", fmt.Sprintf("table/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_import" {
+				continue
+			}
+
+			_, err := tfdynamodb.FindTableByName(ctx, conn, rs.Primary.Attributes[names.AttrTableName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DynamoDB Table %s still exists", rs.Primary.Attributes[names.AttrTableName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableImportExists(ctx context.Context, n string, v *awstypes.ImportTableDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		output, err := tfdynamodb.FindImportByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableImportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/somedoc.json"
+  content = "{\"Item\":{\"%[1]s\":{\"S\":\"test\"},\"field\":{\"S\":\"test\"}}}"
+}
+
+resource "aws_dynamodb_table_import" "test" {
+  table_name = %[1]q
+  hash_key   = %[1]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+
+  input_compression_type = "NONE"
+  input_format           = "DYNAMODB_JSON"
+
+  s3_bucket_source {
+    bucket     = aws_s3_bucket.test.bucket
+    key_prefix = "data"
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	batchGetItemMaxKeys       = 100
+	batchWriteItemMaxRequests = 25
+)
+
+// Unprocessed items and keys are retried with exponential backoff, as recommended by the DynamoDB documentation.
+var batchItemsRetryOptions = retry.Options{
+	BackoffMinDuration: 100 * time.Millisecond,
+	BackoffMultiplier:  2,
+	BackoffMaxDuration: 10 * time.Second,
+}
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTableItemsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	newItems, err := expandTableItems(d.Get("items").(*schema.Set).List(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// In authoritative mode, items that aren't configured are removed.
+	var oldItems map[string]map[string]awstypes.AttributeValue
+	if d.Get("authoritative").(bool) {
+		oldItems, err = findTableItemsByTableName(ctx, conn, tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", tableName, err)
+		}
+	}
+
+	if err := updateTableItems(ctx, conn, tableName, hashKey, rangeKey, oldItems, newItems, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	stateItems := make(map[string]string)
+	var keys []map[string]awstypes.AttributeValue
+	for _, v := range d.Get("items").(*schema.Set).List() {
+		v := v.(string)
+		attributes, err := expandTableItemAttributes(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		key, err := tableItemKey(attributes, hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		stateItems[key] = v
+		keys = append(keys, expandTableItemQueryKey(attributes, hashKey, rangeKey))
+	}
+
+	var items map[string]map[string]awstypes.AttributeValue
+	var err error
+	if d.Get("authoritative").(bool) {
+		items, err = findTableItemsByTableName(ctx, conn, tableName, hashKey, rangeKey)
+	} else {
+		items, err = findTableItemsByKeys(ctx, conn, tableName, hashKey, rangeKey, keys)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0, len(items))
+	for key, item := range items {
+		// Keep the existing JSON representation of unchanged items.
+		if v, ok := stateItems[key]; ok {
+			if attributes, err := expandTableItemAttributes(v); err == nil && reflect.DeepEqual(attributes, item) {
+				tfList = append(tfList, v)
+				continue
+			}
+		}
+
+		v, err := flattenTableItemAttributes(item)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		tfList = append(tfList, strings.TrimSpace(v))
+	}
+
+	if err := d.Set("items", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	o, n := d.GetChange("items")
+	newItems, err := expandTableItems(n.(*schema.Set).List(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var oldItems map[string]map[string]awstypes.AttributeValue
+	if d.Get("authoritative").(bool) {
+		oldItems, err = findTableItemsByTableName(ctx, conn, tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+	} else {
+		oldItems, err = expandTableItems(o.(*schema.Set).List(), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if err := updateTableItems(ctx, conn, tableName, hashKey, rangeKey, oldItems, newItems, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	oldItems, err := expandTableItems(d.Get("items").(*schema.Set).List(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = updateTableItems(ctx, conn, tableName, hashKey, rangeKey, oldItems, nil, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	// Imported resources manage all of the table's items.
+	table, err := findTableByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading DynamoDB Table (%s): %w", d.Id(), err)
+	}
+
+	for _, v := range table.KeySchema {
+		switch v.KeyType {
+		case awstypes.KeyTypeHash:
+			d.Set("hash_key", v.AttributeName)
+		case awstypes.KeyTypeRange:
+			d.Set("range_key", v.AttributeName)
+		}
+	}
+
+	d.Set("authoritative", true)
+	d.Set(names.AttrTableName, d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("items") || !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return nil
+	}
+
+	// Catch missing and duplicate keys at plan time.
+	_, err := expandTableItems(d.Get("items").(*schema.Set).List(), d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	return err
+}
+
+// updateTableItems writes the items in newItems that aren't in oldItems or that differ, and deletes the items in oldItems that aren't in newItems.
+// Items are keyed by tableItemKey.
+func updateTableItems(ctx context.Context, conn *dynamodb.Client, tableName, hashKey, rangeKey string, oldItems, newItems map[string]map[string]awstypes.AttributeValue, timeout time.Duration) error {
+	var requests []awstypes.WriteRequest
+
+	for key, item := range newItems {
+		if v, ok := oldItems[key]; ok && reflect.DeepEqual(v, item) {
+			continue
+		}
+
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	for key, item := range oldItems {
+		if _, ok := newItems[key]; ok {
+			continue
+		}
+
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: expandTableItemQueryKey(item, hashKey, rangeKey),
+			},
+		})
+	}
+
+	return batchWriteTableItems(ctx, conn, tableName, requests, timeout)
+}
+
+// batchWriteTableItems sends the specified write requests in batches, retrying unprocessed items until the timeout elapses.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, chunk := range tfslices.Chunks(requests, batchWriteItemMaxRequests) {
+		unprocessed := chunk
+
+		for r := retry.BeginWithOptions(batchItemsRetryOptions); len(unprocessed) > 0; {
+			if !r.Continue(ctx) {
+				return fmt.Errorf("%d unprocessed items: %w", len(unprocessed), ctx.Err())
+			}
+
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: unprocessed,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			unprocessed = output.UnprocessedItems[tableName]
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified keys, keyed by tableItemKey.
+// Items that don't exist are omitted.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName, hashKey, rangeKey string, keys []map[string]awstypes.AttributeValue) (map[string]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for _, chunk := range tfslices.Chunks(keys, batchGetItemMaxKeys) {
+		unprocessed := chunk
+
+		for r := retry.BeginWithOptions(batchItemsRetryOptions); len(unprocessed) > 0; {
+			if !r.Continue(ctx) {
+				return nil, ctx.Err()
+			}
+
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           unprocessed,
+					},
+				},
+			}
+
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil, &sdkretry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				}
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+			unprocessed = output.UnprocessedKeys[tableName].Keys
+		}
+	}
+
+	return tableItemsByKey(items, hashKey, rangeKey)
+}
+
+// findTableItemsByTableName returns all of the table's items, keyed by tableItemKey.
+func findTableItemsByTableName(ctx context.Context, conn *dynamodb.Client, tableName, hashKey, rangeKey string) (map[string]map[string]awstypes.AttributeValue, error) {
+	input := &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(tableName),
+	}
+	var items []map[string]awstypes.AttributeValue
+
+	pages := dynamodb.NewScanPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+	}
+
+	return tableItemsByKey(items, hashKey, rangeKey)
+}
+
+func tableItemsByKey(items []map[string]awstypes.AttributeValue, hashKey, rangeKey string) (map[string]map[string]awstypes.AttributeValue, error) {
+	m := make(map[string]map[string]awstypes.AttributeValue, len(items))
+
+	for _, item := range items {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, err
+		}
+
+		m[key] = item
+	}
+
+	return m, nil
+}
+
+// expandTableItems returns the items with the specified JSON representations, keyed by tableItemKey.
+func expandTableItems(tfList []interface{}, hashKey, rangeKey string) (map[string]map[string]awstypes.AttributeValue, error) {
+	items := make(map[string]map[string]awstypes.AttributeValue, len(tfList))
+
+	for _, v := range tfList {
+		attributes, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := tableItemKey(attributes, hashKey, rangeKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := items[key]; ok {
+			return nil, fmt.Errorf("duplicate item with key %s", key)
+		}
+
+		items[key] = attributes
+	}
+
+	return items, nil
+}
+
+// tableItemKey returns a string that uniquely identifies the item with the specified attributes in its table.
+// The key is the JSON encoding of the typed key attribute values, with numbers in canonical form so that e.g. "1" and "1.0" are the same key.
+func tableItemKey(attributes map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	keyAttributes := []string{hashKey}
+	if rangeKey != "" {
+		keyAttributes = append(keyAttributes, rangeKey)
+	}
+
+	parts := make([]map[string]string, 0, len(keyAttributes))
+	for _, k := range keyAttributes {
+		switch v := attributes[k].(type) {
+		case *awstypes.AttributeValueMemberB:
+			parts = append(parts, map[string]string{"B": base64.StdEncoding.EncodeToString(v.Value)})
+		case *awstypes.AttributeValueMemberN:
+			n, ok := new(big.Rat).SetString(v.Value)
+			if !ok {
+				return "", fmt.Errorf("key attribute %q is not a valid number: %q", k, v.Value)
+			}
+			parts = append(parts, map[string]string{"N": n.RatString()})
+		case *awstypes.AttributeValueMemberS:
+			parts = append(parts, map[string]string{"S": v.Value})
+		case nil:
+			return "", fmt.Errorf("item is missing key attribute %q", k)
+		default:
+			return "", fmt.Errorf("key attribute %q must be of type S, N or B", k)
+		}
+	}
+
+	b, err := json.Marshal(parts)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTableItemKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		item          string
+		rangeKey      string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "hash key",
+			item:     `{"pk": {"S": "one"}, "value": {"N": "1"}}`,
+			expected: `[{"S":"one"}]`,
+		},
+		{
+			name:     "hash and range keys",
+			item:     `{"pk": {"S": "one"}, "sk": {"N": "2"}}`,
+			rangeKey: "sk",
+			expected: `[{"S":"one"},{"N":"2"}]`,
+		},
+		{
+			name:     "separator in values",
+			item:     `{"pk": {"S": "one|two"}, "sk": {"S": "three"}}`,
+			rangeKey: "sk",
+			expected: `[{"S":"one|two"},{"S":"three"}]`,
+		},
+		{
+			name:     "equivalent numbers",
+			item:     `{"pk": {"N": "1.50"}, "sk": {"N": "1E2"}}`,
+			rangeKey: "sk",
+			expected: `[{"N":"3/2"},{"N":"100"}]`,
+		},
+		{
+			name:     "binary key",
+			item:     `{"pk": {"B": "dGVzdA=="}}`,
+			expected: `[{"B":"dGVzdA=="}]`,
+		},
+		{
+			name:          "missing range key",
+			item:          `{"pk": {"S": "one"}}`,
+			rangeKey:      "sk",
+			expectedError: true,
+		},
+		{
+			name:          "invalid number",
+			item:          `{"pk": {"N": "one"}}`,
+			expectedError: true,
+		},
+		{
+			name:          "invalid key type",
+			item:          `{"pk": {"BOOL": true}}`,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes, err := tfdynamodb.ExpandTableItemAttributes(testCase.item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfdynamodb.TableItemKey(attributes, "pk", testCase.rangeKey)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 60, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 40, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 40),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "40"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "items.*", `{"pk":{"S":"item-0"},"value":{"S":"v2"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_authoritative(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_authoritative(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "items.#", acctest.Ct3),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPutTableItem(ctx, t, rName, "unmanaged")
+				},
+				Config: testAccTableItemsConfig_authoritative(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items.#", acctest.Ct3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPutTableItem(ctx context.Context, t *testing.T, tableName, hashKeyValue string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+	_, err := conn.PutItem(ctx, &dynamodb.PutItemInput{
+		Item: map[string]awstypes.AttributeValue{
+			"pk": &awstypes.AttributeValueMemberS{Value: hashKeyValue},
+		},
+		TableName: aws.String(tableName),
+	})
+
+	if err != nil {
+		t.Fatalf("putting DynamoDB Table (%s) Item: %s", tableName, err)
+	}
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			_, err := tfdynamodb.FindTableByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DynamoDB Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName string, count int, value string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for i in range(%[1]d) : jsonencode({
+    pk    = { S = "item-${i}" }
+    value = { S = %[2]q }
+  })]
+}
+`, count, value))
+}
+
+func testAccTableItemsConfig_authoritative(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name    = aws_dynamodb_table.test.name
+  hash_key      = aws_dynamodb_table.test.hash_key
+  authoritative = true
+
+  items = [for i in range(%[1]d) : jsonencode({
+    pk = { S = "item-${i}" }
+  })]
+}
+`, count))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dynamodb_tables", name="Tables")
+func dataSourceTables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	output, err := findTableNames(ctx, conn, &dynamodb.ListTablesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Tables: %s", err)
+	}
+
+	var arns, tableNames []string
+
+	for _, tableName := range output {
+		tableARN := arn.ARN{
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Partition: meta.(*conns.AWSClient).Partition,
+			Region:    meta.(*conns.AWSClient).Region,
+			Resource:  fmt.Sprintf("table/%s", tableName),
+			Service:   "dynamodb",
+		}.String()
+
+		if len(tagsToMatch) > 0 {
+			tags, err := listTags(ctx, conn, tableARN)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for DynamoDB Table (%s): %s", tableARN, err)
+			}
+
+			if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, tableARN)
+		tableNames = append(tableNames, tableName)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, tableNames)
+
+	return diags
+}
+
+func findTableNames(ctx context.Context, conn *dynamodb.Client, input *dynamodb.ListTablesInput) ([]string, error) {
+	var output []string
+
+	pages := dynamodb.NewListTablesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TableNames...)
+	}
+
+	return output, nil
+}
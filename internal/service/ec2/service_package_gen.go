@@ -1282,6 +1282,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  resourceSecurityGroupRules,
+			TypeName: "aws_vpc_security_group_rules",
+			Name:     "Security Group Rules",
+		},
 		{
 			Factory:  resourceVPNConnection,
 			TypeName: "aws_vpn_connection",
@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The maximum number of rules authorized, revoked or modified in each API call.
+	securityGroupRulesBatchSize = 100
+)
+
+// @SDKResource("aws_vpc_security_group_rules", name="Security Group Rules")
+func resourceSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesCreate,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesUpdate,
+		DeleteWithoutTimeout: resourceSecurityGroupRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecurityGroupRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress":  securityGroupRulesRuleSchema(),
+			"ingress": securityGroupRulesRuleSchema(),
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: resourceSecurityGroupRulesCustomizeDiff,
+	}
+}
+
+func securityGroupRulesRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr_ipv4": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+				"cidr_ipv6": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				},
+				names.AttrDescription: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"from_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      -1,
+					ValidateFunc: validation.IntBetween(-1, 65535),
+				},
+				"ip_protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"prefix_list_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"referenced_security_group_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      -1,
+					ValidateFunc: validation.IntBetween(-1, 65535),
+				},
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	securityGroupID := d.Get("security_group_id").(string)
+
+	if err := syncSecurityGroupRules(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Security Group (%s) Rules: %s", securityGroupID, err)
+	}
+
+	d.SetId(securityGroupID)
+
+	return append(diags, resourceSecurityGroupRulesRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.EC2Conn(ctx)
+
+	_, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group (%s) not found, removing Rules from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s): %s", d.Id(), err)
+	}
+
+	apiObjects, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	// Rules are flattened as configured if they're equivalent to a configured rule,
+	// so that e.g. a protocol number configured as "6" doesn't show a difference from the "tcp" returned by the API.
+	configured := make(map[string]map[string]interface{})
+	for _, key := range []string{"egress", "ingress"} {
+		for _, tfMapRaw := range d.Get(key).(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			configured[expandSecurityGroupRulesRule(tfMap, key == "egress").key(client.AccountID)] = tfMap
+		}
+	}
+
+	var egress, ingress []interface{}
+	for _, apiObject := range apiObjects {
+		rule := flattenSecurityGroupRulesRule(apiObject, client.AccountID)
+
+		tfMap, ok := configured[rule.key(client.AccountID)]
+		if ok {
+			// The description isn't part of the key.
+			tfMap[names.AttrDescription] = rule.description
+		} else {
+			tfMap = rule.tfMap()
+		}
+
+		if rule.egress {
+			egress = append(egress, tfMap)
+		} else {
+			ingress = append(ingress, tfMap)
+		}
+	}
+
+	if err := d.Set("egress", egress); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+	if err := d.Set("ingress", ingress); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+	d.Set("security_group_id", d.Id())
+
+	return diags
+}
+
+func resourceSecurityGroupRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncSecurityGroupRules(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSecurityGroupRulesRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	conns.GlobalMutexKV.Lock(d.Id())
+	defer conns.GlobalMutexKV.Unlock(d.Id())
+
+	apiObjects, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	var egress, ingress []string
+	for _, apiObject := range apiObjects {
+		if aws.BoolValue(apiObject.IsEgress) {
+			egress = append(egress, aws.StringValue(apiObject.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.StringValue(apiObject.SecurityGroupRuleId))
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting VPC Security Group (%s) Rules", d.Id())
+	err = revokeSecurityGroupRules(ctx, conn, d.Id(), ingress, egress)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSecurityGroupRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("security_group_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSecurityGroupRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	accountID := meta.(*conns.AWSClient).AccountID
+	keys := make(map[string]struct{})
+
+	for _, key := range []string{"egress", "ingress"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		for _, tfMapRaw := range d.Get(key).(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := expandSecurityGroupRulesRule(tfMap, key == "egress")
+
+			if n := rule.sourceCount(); n != 1 {
+				return fmt.Errorf("%s rule (%s): exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified, got %d", key, rule, n)
+			}
+
+			k := rule.key(accountID)
+			if _, ok := keys[k]; ok {
+				return fmt.Errorf("duplicate %s rule (%s): rules that differ only in description are not allowed", key, rule)
+			}
+			keys[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// syncSecurityGroupRules makes the security group's rules match the configured rules.
+// Rules that aren't configured are revoked first, to free up rule quota, then descriptions are updated and new rules are authorized.
+func syncSecurityGroupRules(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.EC2Conn(ctx)
+	securityGroupID := d.Get("security_group_id").(string)
+
+	conns.GlobalMutexKV.Lock(securityGroupID)
+	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+
+	apiObjects, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return fmt.Errorf("reading rules: %w", err)
+	}
+
+	current := make(map[string]*securityGroupRulesRule)
+	for _, apiObject := range apiObjects {
+		rule := flattenSecurityGroupRulesRule(apiObject, client.AccountID)
+		current[rule.key(client.AccountID)] = rule
+	}
+
+	var desired []*securityGroupRulesRule
+	for _, key := range []string{"egress", "ingress"} {
+		for _, tfMapRaw := range d.Get(key).(*schema.Set).List() {
+			desired = append(desired, expandSecurityGroupRulesRule(tfMapRaw.(map[string]interface{}), key == "egress"))
+		}
+	}
+
+	var authorizeEgress, authorizeIngress []*ec2.IpPermission
+	var modify []*ec2.SecurityGroupRuleUpdate
+	for _, rule := range desired {
+		k := rule.key(client.AccountID)
+		existing, ok := current[k]
+
+		if !ok {
+			if rule.egress {
+				authorizeEgress = append(authorizeEgress, rule.expandIPPermission())
+			} else {
+				authorizeIngress = append(authorizeIngress, rule.expandIPPermission())
+			}
+
+			continue
+		}
+
+		delete(current, k)
+
+		if existing.description != rule.description {
+			modify = append(modify, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRule:   rule.expandSecurityGroupRuleRequest(),
+				SecurityGroupRuleId: aws.String(existing.securityGroupRuleID),
+			})
+		}
+	}
+
+	// Whatever is left over isn't configured.
+	var revokeEgress, revokeIngress []string
+	for _, rule := range current {
+		if rule.egress {
+			revokeEgress = append(revokeEgress, rule.securityGroupRuleID)
+		} else {
+			revokeIngress = append(revokeIngress, rule.securityGroupRuleID)
+		}
+	}
+
+	if err := revokeSecurityGroupRules(ctx, conn, securityGroupID, revokeIngress, revokeEgress); err != nil {
+		return err
+	}
+
+	for _, chunk := range tfslices.Chunks(modify, securityGroupRulesBatchSize) {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(securityGroupID),
+			SecurityGroupRules: chunk,
+		}
+
+		if _, err := conn.ModifySecurityGroupRulesWithContext(ctx, input); err != nil {
+			return fmt.Errorf("modifying rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(authorizeIngress, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing ingress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(authorizeEgress, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func revokeSecurityGroupRules(ctx context.Context, conn *ec2.EC2, securityGroupID string, ingress, egress []string) error {
+	for _, chunk := range tfslices.Chunks(ingress, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(egress, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// securityGroupRulesRule is a rule managed by the aws_vpc_security_group_rules resource.
+type securityGroupRulesRule struct {
+	cidrIPv4                  string
+	cidrIPv6                  string
+	description               string
+	egress                    bool
+	fromPort                  int64
+	ipProtocol                string
+	prefixListID              string
+	referencedSecurityGroupID string
+	securityGroupRuleID       string
+	toPort                    int64
+}
+
+func (r *securityGroupRulesRule) String() string {
+	source := r.cidrIPv4 + r.cidrIPv6 + r.prefixListID + r.referencedSecurityGroupID
+
+	return fmt.Sprintf("%s %d-%d %s", r.ipProtocol, r.fromPort, r.toPort, source)
+}
+
+func (r *securityGroupRulesRule) sourceCount() int {
+	n := 0
+
+	for _, v := range []string{r.cidrIPv4, r.cidrIPv6, r.prefixListID, r.referencedSecurityGroupID} {
+		if v != "" {
+			n++
+		}
+	}
+
+	return n
+}
+
+// key identifies equivalent rules. Rules are equivalent if they differ only in description.
+func (r *securityGroupRulesRule) key(accountID string) string {
+	protocol := protocolForValue(r.ipProtocol)
+	fromPort, toPort := r.fromPort, r.toPort
+
+	// Ports don't apply to all protocols.
+	if protocol == "-1" {
+		fromPort, toPort = -1, -1
+	}
+
+	// [UserID/]GroupID, without the user ID if it's the caller's account.
+	referencedSecurityGroupID := strings.TrimPrefix(r.referencedSecurityGroupID, accountID+"/")
+
+	return strings.Join([]string{
+		fmt.Sprintf("%t", r.egress),
+		protocol,
+		fmt.Sprintf("%d", fromPort),
+		fmt.Sprintf("%d", toPort),
+		r.cidrIPv4,
+		strings.ToLower(r.cidrIPv6),
+		r.prefixListID,
+		referencedSecurityGroupID,
+	}, "|")
+}
+
+func (r *securityGroupRulesRule) tfMap() map[string]interface{} {
+	return map[string]interface{}{
+		"cidr_ipv4":                    r.cidrIPv4,
+		"cidr_ipv6":                    r.cidrIPv6,
+		names.AttrDescription:          r.description,
+		"from_port":                    int(r.fromPort),
+		"ip_protocol":                  r.ipProtocol,
+		"prefix_list_id":               r.prefixListID,
+		"referenced_security_group_id": r.referencedSecurityGroupID,
+		"to_port":                      int(r.toPort),
+	}
+}
+
+func (r *securityGroupRulesRule) expandIPPermission() *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		FromPort:   aws.Int64(r.fromPort),
+		IpProtocol: aws.String(r.ipProtocol),
+		ToPort:     aws.Int64(r.toPort),
+	}
+
+	var description *string
+	if r.description != "" {
+		description = aws.String(r.description)
+	}
+
+	switch {
+	case r.cidrIPv4 != "":
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(r.cidrIPv4),
+			Description: description,
+		}}
+	case r.cidrIPv6 != "":
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(r.cidrIPv6),
+			Description: description,
+		}}
+	case r.prefixListID != "":
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(r.prefixListID),
+		}}
+	case r.referencedSecurityGroupID != "":
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+		}}
+
+		// [UserID/]GroupID.
+		if parts := strings.Split(r.referencedSecurityGroupID, "/"); len(parts) == 2 {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(parts[1])
+			apiObject.UserIdGroupPairs[0].UserId = aws.String(parts[0])
+		} else {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(r.referencedSecurityGroupID)
+		}
+	}
+
+	return apiObject
+}
+
+func (r *securityGroupRulesRule) expandSecurityGroupRuleRequest() *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		Description: aws.String(r.description),
+		FromPort:    aws.Int64(r.fromPort),
+		IpProtocol:  aws.String(r.ipProtocol),
+		ToPort:      aws.Int64(r.toPort),
+	}
+
+	switch {
+	case r.cidrIPv4 != "":
+		apiObject.CidrIpv4 = aws.String(r.cidrIPv4)
+	case r.cidrIPv6 != "":
+		apiObject.CidrIpv6 = aws.String(r.cidrIPv6)
+	case r.prefixListID != "":
+		apiObject.PrefixListId = aws.String(r.prefixListID)
+	case r.referencedSecurityGroupID != "":
+		// [UserID/]GroupID.
+		parts := strings.Split(r.referencedSecurityGroupID, "/")
+		apiObject.ReferencedGroupId = aws.String(parts[len(parts)-1])
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRulesRule(tfMap map[string]interface{}, egress bool) *securityGroupRulesRule {
+	return &securityGroupRulesRule{
+		cidrIPv4:                  tfMap["cidr_ipv4"].(string),
+		cidrIPv6:                  tfMap["cidr_ipv6"].(string),
+		description:               tfMap[names.AttrDescription].(string),
+		egress:                    egress,
+		fromPort:                  int64(tfMap["from_port"].(int)),
+		ipProtocol:                tfMap["ip_protocol"].(string),
+		prefixListID:              tfMap["prefix_list_id"].(string),
+		referencedSecurityGroupID: tfMap["referenced_security_group_id"].(string),
+		toPort:                    int64(tfMap["to_port"].(int)),
+	}
+}
+
+func flattenSecurityGroupRulesRule(apiObject *ec2.SecurityGroupRule, accountID string) *securityGroupRulesRule {
+	rule := &securityGroupRulesRule{
+		cidrIPv4:            aws.StringValue(apiObject.CidrIpv4),
+		cidrIPv6:            aws.StringValue(apiObject.CidrIpv6),
+		description:         aws.StringValue(apiObject.Description),
+		egress:              aws.BoolValue(apiObject.IsEgress),
+		fromPort:            aws.Int64Value(apiObject.FromPort),
+		ipProtocol:          aws.StringValue(apiObject.IpProtocol),
+		prefixListID:        aws.StringValue(apiObject.PrefixListId),
+		securityGroupRuleID: aws.StringValue(apiObject.SecurityGroupRuleId),
+		toPort:              aws.Int64Value(apiObject.ToPort),
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if v.UserId == nil || aws.StringValue(v.UserId) == accountID {
+			rule.referencedSecurityGroupID = aws.StringValue(v.GroupId)
+		} else {
+			rule.referencedSecurityGroupID = aws.StringValue(v.UserId) + "/" + aws.StringValue(v.GroupId)
+		}
+	}
+
+	return rule
+}
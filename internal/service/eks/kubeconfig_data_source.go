@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthModeExec  = "exec"
+	kubeconfigAuthModeToken = "token"
+)
+
+func kubeconfigAuthMode_Values() []string {
+	return []string{
+		kubeconfigAuthModeExec,
+		kubeconfigAuthModeToken,
+	}
+}
+
+const (
+	defaultKubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	defaultKubeconfigExecCommand    = "aws"
+)
+
+// @SDKDataSource("aws_eks_kubeconfig", name="Kubeconfig")
+func dataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kubeconfigAuthModeExec,
+				ValidateFunc: validation.StringInSlice(kubeconfigAuthMode_Values(), false),
+			},
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAlias: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_authority_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validClusterName,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"exec_api_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  defaultKubeconfigExecAPIVersion,
+			},
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  defaultKubeconfigExecCommand,
+			},
+			"exec_env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.EKSClient(ctx)
+
+	authMode := d.Get("auth_mode").(string)
+	defaultRoleARN := d.Get(names.AttrRoleARN).(string)
+	opts := kubeconfigOptions{
+		execAPIVersion: d.Get("exec_api_version").(string),
+		execCommand:    d.Get("exec_command").(string),
+		execEnv:        flex.ExpandStringValueMap(d.Get("exec_env").(map[string]interface{})),
+		region:         client.Region,
+	}
+
+	var clusters []kubeconfigCluster
+	var tfList []interface{}
+
+	for _, tfMapRaw := range d.Get("cluster").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		cluster, err := findClusterByName(ctx, conn, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", name, err)
+		}
+
+		if cluster.CertificateAuthority == nil || aws.ToString(cluster.Endpoint) == "" {
+			return sdkdiag.AppendErrorf(diags, "EKS Cluster (%s) has no endpoint or certificate authority; is it still being created?", name)
+		}
+
+		c := kubeconfigCluster{
+			alias:                    tfMap[names.AttrAlias].(string),
+			arn:                      aws.ToString(cluster.Arn),
+			certificateAuthorityData: aws.ToString(cluster.CertificateAuthority.Data),
+			endpoint:                 aws.ToString(cluster.Endpoint),
+			name:                     name,
+			roleARN:                  tfMap[names.AttrRoleARN].(string),
+		}
+
+		if c.alias == "" {
+			c.alias = c.arn
+		}
+
+		if c.roleARN == "" {
+			c.roleARN = defaultRoleARN
+		}
+
+		if authMode == kubeconfigAuthModeToken {
+			token, err := clusterAuthToken(ctx, client.STSClient(ctx), name, c.roleARN)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) Authentication Token: %s", name, err)
+			}
+
+			c.token = token
+		}
+
+		clusters = append(clusters, c)
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAlias:              c.alias,
+			names.AttrARN:                c.arn,
+			"certificate_authority_data": c.certificateAuthorityData,
+			names.AttrEndpoint:           c.endpoint,
+			names.AttrName:               c.name,
+			names.AttrRoleARN:            tfMap[names.AttrRoleARN].(string),
+		})
+	}
+
+	currentContext := d.Get("current_context").(string)
+	if currentContext == "" {
+		currentContext = clusters[0].alias
+	}
+
+	kubeconfig, err := renderKubeconfig(clusters, currentContext, opts)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(clusters[0].arn)
+	if err := d.Set("cluster", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cluster: %s", err)
+	}
+	d.Set("current_context", currentContext)
+	d.Set("kubeconfig", kubeconfig)
+
+	return diags
+}
+
+// clusterAuthToken returns an authentication token for the specified cluster.
+// If a role ARN is specified, the token is generated using the role's credentials.
+func clusterAuthToken(ctx context.Context, conn *sts.Client, clusterName, roleARN string) (string, error) {
+	if roleARN != "" {
+		conn = sts.New(conn.Options(), func(o *sts.Options) {
+			o.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(conn, roleARN))
+		})
+	}
+
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generator.GetWithSTS(ctx, clusterName, conn)
+	if err != nil {
+		return "", err
+	}
+
+	return token.Token, nil
+}
+
+type kubeconfigCluster struct {
+	alias                    string
+	arn                      string
+	certificateAuthorityData string
+	endpoint                 string
+	name                     string
+	roleARN                  string
+	token                    string
+}
+
+type kubeconfigOptions struct {
+	execAPIVersion string
+	execCommand    string
+	execEnv        map[string]string
+	region         string
+}
+
+// kubeconfigFile is the kubeconfig file format.
+// See https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/.
+type kubeconfigFile struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		Server                   string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigNamedContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Exec  *kubeconfigExecConfig `yaml:"exec,omitempty"`
+		Token string                `yaml:"token,omitempty"`
+	} `yaml:"user"`
+}
+
+type kubeconfigExecConfig struct {
+	APIVersion         string                 `yaml:"apiVersion"`
+	Command            string                 `yaml:"command"`
+	Args               []string               `yaml:"args"`
+	Env                []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode    string                 `yaml:"interactiveMode"`
+	ProvideClusterInfo bool                   `yaml:"provideClusterInfo"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// renderKubeconfig returns a kubeconfig YAML document with a cluster, context and user for each of the specified clusters.
+// Each cluster's alias names its cluster, context and user entries.
+// Users authenticate with the cluster's token if it has one, otherwise by running `aws eks get-token`.
+func renderKubeconfig(clusters []kubeconfigCluster, currentContext string, opts kubeconfigOptions) (string, error) {
+	config := kubeconfigFile{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		CurrentContext: currentContext,
+	}
+
+	aliases := make(map[string]struct{})
+	var env []kubeconfigExecEnvVar
+
+	for name, value := range opts.execEnv {
+		env = append(env, kubeconfigExecEnvVar{Name: name, Value: value})
+	}
+	sort.Slice(env, func(i, j int) bool {
+		return env[i].Name < env[j].Name
+	})
+
+	for _, c := range clusters {
+		if _, ok := aliases[c.alias]; ok {
+			return "", fmt.Errorf("duplicate cluster alias: %s", c.alias)
+		}
+		aliases[c.alias] = struct{}{}
+
+		var cluster kubeconfigNamedCluster
+		cluster.Name = c.alias
+		cluster.Cluster.CertificateAuthorityData = c.certificateAuthorityData
+		cluster.Cluster.Server = c.endpoint
+		config.Clusters = append(config.Clusters, cluster)
+
+		var kctx kubeconfigNamedContext
+		kctx.Name = c.alias
+		kctx.Context.Cluster = c.alias
+		kctx.Context.User = c.alias
+		config.Contexts = append(config.Contexts, kctx)
+
+		var user kubeconfigNamedUser
+		user.Name = c.alias
+		if c.token != "" {
+			user.User.Token = c.token
+		} else {
+			args := []string{"--region", opts.region, "eks", "get-token", "--cluster-name", c.name, "--output", "json"}
+			if c.roleARN != "" {
+				args = append(args, "--role-arn", c.roleARN)
+			}
+
+			user.User.Exec = &kubeconfigExecConfig{
+				APIVersion:      opts.execAPIVersion,
+				Command:         opts.execCommand,
+				Args:            args,
+				Env:             env,
+				InteractiveMode: "IfAvailable",
+			}
+		}
+		config.Users = append(config.Users, user)
+	}
+
+	if _, ok := aliases[currentContext]; !ok {
+		return "", fmt.Errorf("current context (%s) is not the alias of a cluster", currentContext)
+	}
+
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("marshalling kubeconfig: %w", err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
+)
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "exec"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.alias", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.certificate_authority_data", resourceName, "certificate_authority.0.data"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.endpoint", resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceName, "current_context", resourceName, names.AttrARN),
+					testAccCheckKubeconfig(dataSourceName, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_token(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_token(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "token"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.0.alias", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "current_context", rName),
+					testAccCheckKubeconfig(dataSourceName, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubeconfig(n string, token bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var config struct {
+			CurrentContext string `yaml:"current-context"`
+			Users          []struct {
+				User struct {
+					Exec  map[string]interface{} `yaml:"exec"`
+					Token string                 `yaml:"token"`
+				} `yaml:"user"`
+			} `yaml:"users"`
+		}
+
+		if err := yaml.Unmarshal([]byte(rs.Primary.Attributes["kubeconfig"]), &config); err != nil {
+			return fmt.Errorf("parsing kubeconfig: %w", err)
+		}
+
+		if got, want := config.CurrentContext, rs.Primary.Attributes["current_context"]; got != want {
+			return fmt.Errorf("current-context = %s, want %s", got, want)
+		}
+
+		if len(config.Users) != 1 {
+			return fmt.Errorf("got %d users, want 1", len(config.Users))
+		}
+
+		user := config.Users[0].User
+
+		if !token {
+			if user.Exec == nil {
+				return fmt.Errorf("expected exec user")
+			}
+
+			return nil
+		}
+
+		identity, err := tfeks.NewVerifier(rs.Primary.Attributes["cluster.0.name"]).Verify(user.Token)
+		if err != nil {
+			return fmt.Errorf("verifying token: %w", err)
+		}
+
+		if identity.ARN == "" {
+			return fmt.Errorf("unexpected blank ARN for token identity")
+		}
+
+		return nil
+	}
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_token(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_kubeconfig" "test" {
+  auth_mode = "token"
+
+  cluster {
+    alias = %[1]q
+    name  = aws_eks_cluster.test.name
+  }
+}
+`, rName))
+}
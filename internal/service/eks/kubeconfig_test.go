@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	opts := kubeconfigOptions{
+		execAPIVersion: defaultKubeconfigExecAPIVersion,
+		execCommand:    defaultKubeconfigExecCommand,
+		execEnv: map[string]string{
+			"AWS_PROFILE":                "prod",
+			"AWS_STS_REGIONAL_ENDPOINTS": "regional",
+		},
+		region: "us-west-2", //lintignore:AWSAT003
+	}
+	clusters := []kubeconfigCluster{
+		{
+			alias:                    "blue",
+			arn:                      "arn:aws:eks:us-west-2:123456789012:cluster/blue", //lintignore:AWSAT003,AWSAT005
+			certificateAuthorityData: "Q0EgREFUQQ==",
+			endpoint:                 "https://BLUE.gr7.us-west-2.eks.amazonaws.com", //lintignore:AWSAT003
+			name:                     "blue",
+			roleARN:                  "arn:aws:iam::123456789012:role/admin", //lintignore:AWSAT005
+		},
+		{
+			alias:                    "green",
+			arn:                      "arn:aws:eks:us-west-2:123456789012:cluster/green", //lintignore:AWSAT003,AWSAT005
+			certificateAuthorityData: "Q0EgREFUQQ==",
+			endpoint:                 "https://GREEN.gr7.us-west-2.eks.amazonaws.com", //lintignore:AWSAT003
+			name:                     "green",
+			token:                    "k8s-aws-v1.dG9rZW4",
+		},
+	}
+
+	got, err := renderKubeconfig(clusters, "green", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `apiVersion: v1
+kind: Config
+clusters:
+- name: blue
+  cluster:
+    certificate-authority-data: Q0EgREFUQQ==
+    server: https://BLUE.gr7.us-west-2.eks.amazonaws.com
+- name: green
+  cluster:
+    certificate-authority-data: Q0EgREFUQQ==
+    server: https://GREEN.gr7.us-west-2.eks.amazonaws.com
+contexts:
+- name: blue
+  context:
+    cluster: blue
+    user: blue
+- name: green
+  context:
+    cluster: green
+    user: green
+current-context: green
+preferences: {}
+users:
+- name: blue
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args:
+      - --region
+      - us-west-2
+      - eks
+      - get-token
+      - --cluster-name
+      - blue
+      - --output
+      - json
+      - --role-arn
+      - arn:aws:iam::123456789012:role/admin
+      env:
+      - name: AWS_PROFILE
+        value: prod
+      - name: AWS_STS_REGIONAL_ENDPOINTS
+        value: regional
+      interactiveMode: IfAvailable
+      provideClusterInfo: false
+- name: green
+  user:
+    token: k8s-aws-v1.dG9rZW4
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRenderKubeconfig_errors(t *testing.T) {
+	t.Parallel()
+
+	cluster := kubeconfigCluster{
+		alias:    "blue",
+		endpoint: "https://BLUE.gr7.us-west-2.eks.amazonaws.com", //lintignore:AWSAT003
+		name:     "blue",
+	}
+
+	if _, err := renderKubeconfig([]kubeconfigCluster{cluster, cluster}, "blue", kubeconfigOptions{}); err == nil {
+		t.Error("duplicate alias: expected error")
+	}
+
+	if _, err := renderKubeconfig([]kubeconfigCluster{cluster}, "green", kubeconfigOptions{}); err == nil {
+		t.Error("unknown current context: expected error")
+	}
+}
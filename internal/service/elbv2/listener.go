@@ -963,6 +963,10 @@ func expandLbListenerActionForwardConfigTargetGroupStickinessConfig(l []interfac
 	}
 }
 
+// flattenLbListenerActions flattens listener or listener rule actions.
+// d may be nil (e.g. for data sources), in which case forward actions are
+// flattened into both target_group_arn and forward and no client secret is
+// carried over from configuration.
 func flattenLbListenerActions(d *schema.ResourceData, attrName string, actions []awstypes.Action) []interface{} {
 	if len(actions) == 0 {
 		return []interface{}{}
@@ -978,7 +982,11 @@ func flattenLbListenerActions(d *schema.ResourceData, attrName string, actions [
 
 		switch action.Type {
 		case awstypes.ActionTypeEnumForward:
-			flattenLbForwardAction(d, attrName, i, action, m)
+			if d == nil {
+				flattenLbForwardActionBoth(action, m)
+			} else {
+				flattenLbForwardAction(d, attrName, i, action, m)
+			}
 
 		case awstypes.ActionTypeEnumRedirect:
 			m["redirect"] = flattenLbListenerActionRedirectConfig(action.RedirectConfig)
@@ -993,8 +1001,10 @@ func flattenLbListenerActions(d *schema.ResourceData, attrName string, actions [
 			// The LB API currently provides no way to read the ClientSecret
 			// Instead we passthrough the configuration value into the state
 			var clientSecret string
-			if v, ok := d.GetOk(attrName + "." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret"); ok {
-				clientSecret = v.(string)
+			if d != nil {
+				if v, ok := d.GetOk(attrName + "." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret"); ok {
+					clientSecret = v.(string)
+				}
 			}
 
 			m["authenticate_oidc"] = flattenAuthenticateOIDCActionConfig(action.AuthenticateOidcConfig, clientSecret)
@@ -49,213 +49,7 @@ func DataSourceListener() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrDefaultAction: {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"authenticate_cognito": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"authentication_request_extra_params": {
-										Type:     schema.TypeMap,
-										Computed: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"on_unauthenticated_request": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrScope: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"session_cookie_name": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"session_timeout": {
-										Type:     schema.TypeInt,
-										Computed: true,
-									},
-									"user_pool_arn": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"user_pool_client_id": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"user_pool_domain": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-						"authenticate_oidc": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"authentication_request_extra_params": {
-										Type:     schema.TypeMap,
-										Computed: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"authorization_endpoint": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrClientID: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrClientSecret: {
-										Type:      schema.TypeString,
-										Computed:  true,
-										Sensitive: true,
-									},
-									names.AttrIssuer: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"on_unauthenticated_request": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrScope: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"session_cookie_name": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"session_timeout": {
-										Type:     schema.TypeInt,
-										Computed: true,
-									},
-									"token_endpoint": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"user_info_endpoint": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-						"fixed_response": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrContentType: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"message_body": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrStatusCode: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-						"forward": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"stickiness": {
-										Type:     schema.TypeList,
-										Computed: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrDuration: {
-													Type:     schema.TypeInt,
-													Computed: true,
-												},
-												names.AttrEnabled: {
-													Type:     schema.TypeBool,
-													Computed: true,
-												},
-											},
-										},
-									},
-									"target_group": {
-										Type:     schema.TypeSet,
-										Computed: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrARN: {
-													Type:     schema.TypeString,
-													Computed: true,
-												},
-												names.AttrWeight: {
-													Type:     schema.TypeInt,
-													Computed: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"order": {
-							Type:     schema.TypeInt,
-							Computed: true,
-						},
-						"redirect": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"host": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrPath: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrPort: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrProtocol: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"query": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									names.AttrStatusCode: {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-						"target_group_arn": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						names.AttrType: {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
+			names.AttrDefaultAction: listenerActionsDataSourceSchema(),
 			"load_balancer_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -368,3 +162,214 @@ func dataSourceListenerRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+// listenerActionsDataSourceSchema returns the computed schema for listener and listener rule actions.
+func listenerActionsDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"authenticate_cognito": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authentication_request_extra_params": {
+								Type:     schema.TypeMap,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"on_unauthenticated_request": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrScope: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"session_cookie_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"session_timeout": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"user_pool_arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"user_pool_client_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"user_pool_domain": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"authenticate_oidc": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authentication_request_extra_params": {
+								Type:     schema.TypeMap,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"authorization_endpoint": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrClientID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrClientSecret: {
+								Type:      schema.TypeString,
+								Computed:  true,
+								Sensitive: true,
+							},
+							names.AttrIssuer: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"on_unauthenticated_request": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrScope: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"session_cookie_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"session_timeout": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"token_endpoint": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"user_info_endpoint": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"fixed_response": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrContentType: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"message_body": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrStatusCode: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"forward": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"stickiness": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrDuration: {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+										names.AttrEnabled: {
+											Type:     schema.TypeBool,
+											Computed: true,
+										},
+									},
+								},
+							},
+							"target_group": {
+								Type:     schema.TypeSet,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrARN: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										names.AttrWeight: {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"order": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"redirect": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrPath: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrPort: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrProtocol: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"query": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrStatusCode: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"target_group_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrType: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
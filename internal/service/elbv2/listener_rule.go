@@ -603,16 +603,11 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	// The listener arn isn't in the response but can be derived from the rule arn
 	d.Set("listener_arn", ListenerARNFromRuleARN(aws.ToString(rule.RuleArn)))
 
-	// Rules are evaluated in priority order, from the lowest value to the highest value. The default rule has the lowest priority.
-	if aws.ToString(rule.Priority) == "default" {
-		d.Set(names.AttrPriority, listenerRulePriorityDefault)
-	} else {
-		if priority, err := strconv.Atoi(aws.ToString(rule.Priority)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "Cannot convert rule priority %q to int: %s", aws.ToString(rule.Priority), err)
-		} else {
-			d.Set(names.AttrPriority, priority)
-		}
+	priority, err := flattenListenerRulePriority(rule.Priority)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
+	d.Set(names.AttrPriority, priority)
 
 	sort.Slice(rule.Actions, func(i, j int) bool {
 		return aws.ToInt32(rule.Actions[i].Order) < aws.ToInt32(rule.Actions[j].Order)
@@ -621,61 +616,7 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting action: %s", err)
 	}
 
-	conditions := make([]interface{}, len(rule.Conditions))
-	for i, condition := range rule.Conditions {
-		conditionMap := make(map[string]interface{})
-
-		switch aws.ToString(condition.Field) {
-		case "host-header":
-			conditionMap["host_header"] = []interface{}{
-				map[string]interface{}{
-					names.AttrValues: flex.FlattenStringValueSet(condition.HostHeaderConfig.Values),
-				},
-			}
-
-		case "http-header":
-			conditionMap["http_header"] = []interface{}{
-				map[string]interface{}{
-					"http_header_name": aws.ToString(condition.HttpHeaderConfig.HttpHeaderName),
-					names.AttrValues:   flex.FlattenStringValueSet(condition.HttpHeaderConfig.Values),
-				},
-			}
-
-		case "http-request-method":
-			conditionMap["http_request_method"] = []interface{}{
-				map[string]interface{}{
-					names.AttrValues: flex.FlattenStringValueSet(condition.HttpRequestMethodConfig.Values),
-				},
-			}
-
-		case "path-pattern":
-			conditionMap["path_pattern"] = []interface{}{
-				map[string]interface{}{
-					names.AttrValues: flex.FlattenStringValueSet(condition.PathPatternConfig.Values),
-				},
-			}
-
-		case "query-string":
-			values := make([]interface{}, len(condition.QueryStringConfig.Values))
-			for k, value := range condition.QueryStringConfig.Values {
-				values[k] = map[string]interface{}{
-					names.AttrKey:   aws.ToString(value.Key),
-					names.AttrValue: aws.ToString(value.Value),
-				}
-			}
-			conditionMap["query_string"] = values
-
-		case "source-ip":
-			conditionMap["source_ip"] = []interface{}{
-				map[string]interface{}{
-					names.AttrValues: flex.FlattenStringValueSet(condition.SourceIpConfig.Values),
-				},
-			}
-		}
-
-		conditions[i] = conditionMap
-	}
-	if err := d.Set(names.AttrCondition, conditions); err != nil {
+	if err := d.Set(names.AttrCondition, flattenListenerRuleConditions(rule.Conditions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting condition: %s", err)
 	}
 
@@ -956,3 +897,80 @@ func lbListenerRuleConditions(conditions []interface{}) ([]awstypes.RuleConditio
 	}
 	return elbConditions, nil
 }
+
+// flattenListenerRuleConditions converts elasticloadbalancingv2/types.RuleCondition objects
+// into the nested structure used by the condition block.
+func flattenListenerRuleConditions(apiObjects []awstypes.RuleCondition) []interface{} {
+	conditions := make([]interface{}, len(apiObjects))
+	for i, condition := range apiObjects {
+		conditionMap := make(map[string]interface{})
+
+		switch aws.ToString(condition.Field) {
+		case "host-header":
+			conditionMap["host_header"] = []interface{}{
+				map[string]interface{}{
+					names.AttrValues: flex.FlattenStringValueSet(condition.HostHeaderConfig.Values),
+				},
+			}
+
+		case "http-header":
+			conditionMap["http_header"] = []interface{}{
+				map[string]interface{}{
+					"http_header_name": aws.ToString(condition.HttpHeaderConfig.HttpHeaderName),
+					names.AttrValues:   flex.FlattenStringValueSet(condition.HttpHeaderConfig.Values),
+				},
+			}
+
+		case "http-request-method":
+			conditionMap["http_request_method"] = []interface{}{
+				map[string]interface{}{
+					names.AttrValues: flex.FlattenStringValueSet(condition.HttpRequestMethodConfig.Values),
+				},
+			}
+
+		case "path-pattern":
+			conditionMap["path_pattern"] = []interface{}{
+				map[string]interface{}{
+					names.AttrValues: flex.FlattenStringValueSet(condition.PathPatternConfig.Values),
+				},
+			}
+
+		case "query-string":
+			values := make([]interface{}, len(condition.QueryStringConfig.Values))
+			for k, value := range condition.QueryStringConfig.Values {
+				values[k] = map[string]interface{}{
+					names.AttrKey:   aws.ToString(value.Key),
+					names.AttrValue: aws.ToString(value.Value),
+				}
+			}
+			conditionMap["query_string"] = values
+
+		case "source-ip":
+			conditionMap["source_ip"] = []interface{}{
+				map[string]interface{}{
+					names.AttrValues: flex.FlattenStringValueSet(condition.SourceIpConfig.Values),
+				},
+			}
+		}
+
+		conditions[i] = conditionMap
+	}
+
+	return conditions
+}
+
+// flattenListenerRulePriority converts the API's string rule priority into an int.
+// Rules are evaluated in priority order, from the lowest value to the highest value. The default rule has the lowest priority.
+func flattenListenerRulePriority(priority *string) (int, error) {
+	if aws.ToString(priority) == "default" {
+		return listenerRulePriorityDefault, nil
+	}
+
+	v, err := strconv.Atoi(aws.ToString(priority))
+
+	if err != nil {
+		return 0, fmt.Errorf("Cannot convert rule priority %q to int: %w", aws.ToString(priority), err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lb_listener_rule", name="Listener Rule")
+func dataSourceListenerRule() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceListenerRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: listenerActionsDataSourceSchema(),
+			names.AttrARN: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidARN,
+				ExactlyOneOf:  []string{names.AttrARN, "listener_arn"},
+				ConflictsWith: []string{names.AttrPriority},
+			},
+			names.AttrCondition: listenerRuleConditionsDataSourceSchema(),
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{names.AttrARN, "listener_arn"},
+				RequiredWith: []string{names.AttrPriority},
+			},
+			names.AttrPriority: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntBetween(listenerRulePriorityMin, listenerRulePriorityMax),
+				ConflictsWith: []string{names.AttrARN},
+				RequiredWith:  []string{"listener_arn"},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &elasticloadbalancingv2.DescribeRulesInput{}
+	filter := tfslices.PredicateTrue[*awstypes.Rule]()
+
+	if v, ok := d.GetOk(names.AttrARN); ok {
+		input.RuleArns = []string{v.(string)}
+	} else {
+		input.ListenerArn = aws.String(d.Get("listener_arn").(string))
+		priority := d.Get(names.AttrPriority).(int)
+		filter = func(v *awstypes.Rule) bool {
+			p, err := flattenListenerRulePriority(v.Priority)
+			return err == nil && p == priority
+		}
+	}
+
+	rule, err := findListenerRule(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ELBv2 Listener Rule", err))
+	}
+
+	ruleARN := aws.ToString(rule.RuleArn)
+	d.SetId(ruleARN)
+	tfMap, err := flattenListenerRule(rule)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	for k, v := range tfMap {
+		if err := d.Set(k, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+		}
+	}
+
+	tags, err := listTagsV2(ctx, conn, ruleARN)
+
+	if errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
+		log.Printf("[WARN] Unable to list tags for ELBv2 Listener Rule %s: %s", ruleARN, err)
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for (%s): %s", ruleARN, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func listenerRuleConditionsDataSourceSchema() *schema.Schema {
+	valuesSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_header": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrValues: valuesSchema(),
+						},
+					},
+				},
+				"http_header": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"http_header_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrValues: valuesSchema(),
+						},
+					},
+				},
+				"http_request_method": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrValues: valuesSchema(),
+						},
+					},
+				},
+				"path_pattern": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrValues: valuesSchema(),
+						},
+					},
+				},
+				"query_string": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrKey: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"source_ip": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrValues: valuesSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func findListenerRule(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeRulesInput, filter tfslices.Predicate[*awstypes.Rule]) (*awstypes.Rule, error) {
+	output, err := findListenerRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findListenerRules(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeRulesInput, filter tfslices.Predicate[*awstypes.Rule]) ([]awstypes.Rule, error) {
+	var output []awstypes.Rule
+
+	for {
+		page, err := conn.DescribeRules(ctx, input)
+
+		if errs.IsA[*awstypes.ListenerNotFoundException](err) || errs.IsA[*awstypes.RuleNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Rules {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if page.NextMarker == nil {
+			break
+		}
+
+		input.Marker = page.NextMarker
+	}
+
+	return output, nil
+}
+
+// flattenListenerRule returns the attributes common to the listener rule data sources.
+func flattenListenerRule(apiObject *awstypes.Rule) (map[string]interface{}, error) {
+	priority, err := flattenListenerRulePriority(apiObject.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := apiObject.Actions
+	sort.Slice(actions, func(i, j int) bool {
+		return aws.ToInt32(actions[i].Order) < aws.ToInt32(actions[j].Order)
+	})
+
+	return map[string]interface{}{
+		names.AttrAction:    flattenLbListenerActions(nil, names.AttrAction, actions),
+		names.AttrARN:       aws.ToString(apiObject.RuleArn),
+		names.AttrCondition: flattenListenerRuleConditions(apiObject.Conditions),
+		"is_default":        aws.ToBool(apiObject.IsDefault),
+		// The listener arn isn't in the response but can be derived from the rule arn
+		"listener_arn":     ListenerARNFromRuleARN(aws.ToString(apiObject.RuleArn)),
+		names.AttrPriority: priority,
+	}, nil
+}
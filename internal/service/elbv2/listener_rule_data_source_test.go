@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2ListenerRuleDataSource_byARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_listener_rule.test"
+	resourceName := "aws_lb_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleDataSourceConfig_byARN(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "listener_arn", resourceName, "listener_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPriority, resourceName, names.AttrPriority),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "action.0.type", "forward"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "action.0.target_group_arn", resourceName, "action.0.target_group_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "condition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "condition.0.path_pattern.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "condition.0.path_pattern.0.values.*", "/static/*"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerRuleDataSource_byListenerAndPriority(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_listener_rule.test"
+	resourceName := "aws_lb_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleDataSourceConfig_byListenerAndPriority(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "listener_arn", resourceName, "listener_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPriority, resourceName, names.AttrPriority),
+					resource.TestCheckResourceAttr(dataSourceName, "condition.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerRuleDataSource_missingArguments(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListenerRuleDataSourceConfig_missingArguments,
+				ExpectError: regexache.MustCompile(`one of .arn,listener_arn. must be specified`),
+			},
+		},
+	})
+}
+
+func testAccListenerRuleDataSourceConfig_byARN(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_basic(rName), `
+data "aws_lb_listener_rule" "test" {
+  arn = aws_lb_listener_rule.test.arn
+}
+`)
+}
+
+func testAccListenerRuleDataSourceConfig_byListenerAndPriority(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_basic(rName), `
+data "aws_lb_listener_rule" "test" {
+  listener_arn = aws_lb_listener_rule.test.listener_arn
+  priority     = aws_lb_listener_rule.test.priority
+}
+`)
+}
+
+const testAccListenerRuleDataSourceConfig_missingArguments = `
+data "aws_lb_listener_rule" "test" {}
+`
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lb_listener_rules", name="Listener Rules")
+func dataSourceListenerRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceListenerRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"priorities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: listenerActionsDataSourceSchema(),
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCondition: listenerRuleConditionsDataSourceSchema(),
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"listener_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPriority: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceListenerRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	listenerARN := d.Get("listener_arn").(string)
+	input := &elasticloadbalancingv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+
+	rules, err := findListenerRules(ctx, conn, input, tfslices.PredicateTrue[*awstypes.Rule]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener (%s) Rules: %s", listenerARN, err)
+	}
+
+	var ruleARNs []string
+	var priorities []int
+	var tfList []interface{}
+
+	for _, v := range rules {
+		tfMap, err := flattenListenerRule(&v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		ruleARNs = append(ruleARNs, aws.ToString(v.RuleArn))
+		// Only report priorities that are in use by non-default rules.
+		if !aws.ToBool(v.IsDefault) {
+			priorities = append(priorities, tfMap[names.AttrPriority].(int))
+		}
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(listenerARN)
+	d.Set(names.AttrARNs, ruleARNs)
+	d.Set("priorities", priorities)
+	if err := d.Set("rules", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rules: %s", err)
+	}
+
+	return diags
+}
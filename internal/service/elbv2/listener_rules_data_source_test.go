@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2ListenerRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_listener_rules.test"
+	resourceName := "aws_lb_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The listener's default rule is always returned alongside the rule we created.
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "priorities.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "priorities.0", resourceName, names.AttrPriority),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						"is_default":                 acctest.CtFalse,
+						"condition.#":                acctest.Ct1,
+						"condition.0.path_pattern.#": acctest.Ct1,
+						names.AttrPriority:           "100",
+						"action.#":                   acctest.Ct1,
+						"action.0.type":              "forward",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						"is_default":  acctest.CtTrue,
+						"condition.#": acctest.Ct0,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccListenerRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_basic(rName), `
+data "aws_lb_listener_rules" "test" {
+  listener_arn = aws_lb_listener_rule.test.listener_arn
+}
+`)
+}
@@ -52,6 +52,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceListener,
 			TypeName: "aws_lb_listener",
 		},
+		{
+			Factory:  dataSourceListenerRule,
+			TypeName: "aws_lb_listener_rule",
+			Name:     "Listener Rule",
+		},
+		{
+			Factory:  dataSourceListenerRules,
+			TypeName: "aws_lb_listener_rules",
+			Name:     "Listener Rules",
+		},
 		{
 			Factory:  DataSourceTargetGroup,
 			TypeName: "aws_lb_target_group",
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_api_destination", name="API Destination")
+func dataSourceAPIDestination() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAPIDestinationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invocation_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invocation_rate_limit_per_second": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAPIDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := findAPIDestinationByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge API Destination (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Name))
+	d.Set(names.AttrARN, output.ApiDestinationArn)
+	d.Set("connection_arn", output.ConnectionArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("http_method", output.HttpMethod)
+	d.Set("invocation_endpoint", output.InvocationEndpoint)
+	d.Set("invocation_rate_limit_per_second", output.InvocationRateLimitPerSecond)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrState, output.ApiDestinationState)
+
+	return diags
+}
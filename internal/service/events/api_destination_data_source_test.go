@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsAPIDestinationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_api_destination.test"
+	resourceName := "aws_cloudwatch_event_api_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIDestinationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connection_arn", resourceName, "connection_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "http_method", resourceName, "http_method"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invocation_endpoint", resourceName, "invocation_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invocation_rate_limit_per_second", resourceName, "invocation_rate_limit_per_second"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrState),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIDestinationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAPIDestinationConfig_basic(rName, "https://example.com/", "POST"), `
+data "aws_cloudwatch_event_api_destination" "test" {
+  name = aws_cloudwatch_event_api_destination.test.name
+}
+`)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_archive", name="Archive")
+func dataSourceArchive() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceArchiveRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_source_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validArchiveName,
+			},
+			"retention_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArchiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := findArchiveByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Archive (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ArchiveName))
+	d.Set(names.AttrARN, output.ArchiveArn)
+	if output.CreationTime != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(output.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationTime, nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("event_count", output.EventCount)
+	pattern, err := ruleEventPatternJSONDecoder(aws.ToString(output.EventPattern))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set("event_pattern", pattern)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set(names.AttrName, output.ArchiveName)
+	d.Set("retention_days", output.RetentionDays)
+	d.Set("size_bytes", output.SizeBytes)
+	d.Set(names.AttrState, output.State)
+	d.Set("state_reason", output.StateReason)
+
+	return diags
+}
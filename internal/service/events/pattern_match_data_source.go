@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_match", name="Pattern Match")
+func dataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"event": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"matches": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	eventPattern, err := ruleEventPatternJSONDecoder(d.Get("event_pattern").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := testEventPattern(ctx, conn, eventPattern, d.Get("event").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing EventBridge event pattern: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("matches", output.Result)
+
+	return diags
+}
+
+func testEventPattern(ctx context.Context, conn *eventbridge.Client, eventPattern, event string) (*eventbridge.TestEventPatternOutput, error) {
+	input := &eventbridge.TestEventPatternInput{
+		Event:        aws.String(event),
+		EventPattern: aws.String(eventPattern),
+	}
+
+	return conn.TestEventPattern(ctx, input)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -143,7 +144,10 @@ func resourceRule() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateRuleEventPatternDiff,
+		),
 	}
 }
 
@@ -309,6 +313,49 @@ func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// validateRuleEventPatternDiff validates a new or changed rule event pattern if plan-time validation is enabled.
+// EventBridge validates the pattern by testing it against a sample event; whether the event matches is ignored.
+func validateRuleEventPatternDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	if !client.PlanTimeValidation {
+		return nil
+	}
+
+	if !d.HasChange("event_pattern") || !d.NewValueKnown("event_pattern") {
+		return nil
+	}
+
+	eventPattern, err := ruleEventPatternJSONDecoder(d.Get("event_pattern").(string))
+	if err != nil || eventPattern == "" {
+		// Invalid JSON is reported by schema validation.
+		return nil
+	}
+
+	conn := client.EventsClient(ctx)
+
+	// TestEventPattern requires an event with all of the standard fields, from the caller's account.
+	event, err := json.Marshal(map[string]any{
+		"account":     client.AccountID,
+		"detail":      map[string]any{},
+		"detail-type": "Terraform Plan-Time Validation",
+		"id":          "00000000-0000-0000-0000-000000000000",
+		"region":      client.Region,
+		"resources":   []string{},
+		"source":      "terraform",
+		"time":        time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := testEventPattern(ctx, conn, eventPattern, string(event)); err != nil {
+		return fmt.Errorf("invalid EventBridge Rule event pattern: %w", err)
+	}
+
+	return nil
+}
+
 func retryPutRule(ctx context.Context, conn *eventbridge.Client, input *eventbridge.PutRuleInput) (string, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
 		return conn.PutRule(ctx, input)
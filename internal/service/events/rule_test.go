@@ -29,6 +29,26 @@ func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.EventsServiceID, testAccErrorCheckSkip)
 }
 
+func TestAccEventsRule_planTimeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Valid JSON, but pattern values must be arrays.
+				Config:      acctest.ConfigCompose(acctest.ConfigPlanTimeValidation(), testAccRuleConfig_pattern(rName, `{"source": "aws.ec2"}`)),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`invalid EventBridge Rule event pattern`),
+			},
+		},
+	})
+}
+
 func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	return acctest.ErrorCheckSkipMessagesContaining(t,
 		"Operation is disabled in this region",
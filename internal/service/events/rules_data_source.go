@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_rules", name="Rules")
+func dataSourceRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validBusNameOrARN,
+				Default:      DefaultEventBusName,
+			},
+			names.AttrNamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRuleName,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_pattern": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRoleARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrScheduleExpression: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	eventBusName := d.Get("event_bus_name").(string)
+	input := &eventbridge.ListRulesInput{
+		EventBusName: aws.String(eventBusName),
+	}
+
+	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
+		input.NamePrefix = aws.String(v.(string))
+	}
+
+	output, err := findRules(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Rules (%s): %s", eventBusName, err)
+	}
+
+	var ruleARNs, ruleNames []string
+
+	for _, v := range output {
+		ruleARNs = append(ruleARNs, aws.ToString(v.Arn))
+		ruleNames = append(ruleNames, aws.ToString(v.Name))
+	}
+
+	rules, err := flattenRules(output)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(eventBusName)
+	d.Set(names.AttrARNs, ruleARNs)
+	d.Set(names.AttrNames, ruleNames)
+	if err := d.Set("rules", rules); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rules: %s", err)
+	}
+
+	return diags
+}
+
+func findRules(ctx context.Context, conn *eventbridge.Client, input *eventbridge.ListRulesInput) ([]types.Rule, error) {
+	var output []types.Rule
+
+	err := listRulesPages(ctx, conn, input, func(page *eventbridge.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Rules...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenRules(apiObjects []types.Rule) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		pattern, err := ruleEventPatternJSONDecoder(aws.ToString(apiObject.EventPattern))
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:                aws.ToString(apiObject.Arn),
+			names.AttrDescription:        aws.ToString(apiObject.Description),
+			"event_pattern":              pattern,
+			"managed_by":                 aws.ToString(apiObject.ManagedBy),
+			names.AttrName:               aws.ToString(apiObject.Name),
+			names.AttrRoleARN:            aws.ToString(apiObject.RoleArn),
+			names.AttrScheduleExpression: aws.ToString(apiObject.ScheduleExpression),
+			names.AttrState:              string(apiObject.State),
+		})
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_rules.test"
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesDataSourceConfig_basic(rName, busName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.event_pattern", resourceName, "event_pattern"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.state", resourceName, names.AttrState),
+				),
+			},
+		},
+	})
+}
+
+func testAccRulesDataSourceConfig_basic(rName, busName string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_busName(rName, busName, "test"), `
+data "aws_cloudwatch_event_rules" "test" {
+  event_bus_name = aws_cloudwatch_event_rule.test.event_bus_name
+  name_prefix    = aws_cloudwatch_event_rule.test.name
+}
+`)
+}
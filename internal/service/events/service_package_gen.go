@@ -24,6 +24,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAPIDestination,
+			TypeName: "aws_cloudwatch_event_api_destination",
+			Name:     "API Destination",
+		},
+		{
+			Factory:  dataSourceArchive,
+			TypeName: "aws_cloudwatch_event_archive",
+			Name:     "Archive",
+		},
 		{
 			Factory:  dataSourceBus,
 			TypeName: "aws_cloudwatch_event_bus",
@@ -34,6 +44,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_event_connection",
 			Name:     "Connection",
 		},
+		{
+			Factory:  dataSourcePatternMatch,
+			TypeName: "aws_cloudwatch_event_pattern_match",
+			Name:     "Pattern Match",
+		},
+		{
+			Factory:  dataSourceRule,
+			TypeName: "aws_cloudwatch_event_rule",
+			Name:     "Rule",
+		},
+		{
+			Factory:  dataSourceRules,
+			TypeName: "aws_cloudwatch_event_rules",
+			Name:     "Rules",
+		},
 		{
 			Factory:  dataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
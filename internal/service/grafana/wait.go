@@ -7,91 +7,58 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
-func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{managedgrafana.WorkspaceStatusCreating},
-		Target:  []string{managedgrafana.WorkspaceStatusActive},
-		Refresh: statusWorkspaceStatus(ctx, conn, id),
-		Timeout: timeout,
+func workspaceFinder(conn *managedgrafana.ManagedGrafana, id string) func(context.Context) (*managedgrafana.WorkspaceDescription, error) {
+	return func(ctx context.Context) (*managedgrafana.WorkspaceDescription, error) {
+		return FindWorkspaceByID(ctx, conn, id)
 	}
+}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
-		return output, err
-	}
+func workspaceStatus(v *managedgrafana.WorkspaceDescription) string {
+	return aws.StringValue(v.Status)
+}
 
-	return nil, err
+func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
+	return retry.Waiter(workspaceFinder(conn, id), workspaceStatus).
+		Pending(managedgrafana.WorkspaceStatusCreating).
+		Target(managedgrafana.WorkspaceStatusActive).
+		Run(ctx, timeout)
 }
 
 func waitWorkspaceUpdated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{managedgrafana.WorkspaceStatusUpdating, managedgrafana.WorkspaceStatusVersionUpdating},
-		Target:  []string{managedgrafana.WorkspaceStatusActive},
-		Refresh: statusWorkspaceStatus(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
-		return output, err
-	}
-
-	return nil, err
+	return retry.Waiter(workspaceFinder(conn, id), workspaceStatus).
+		Pending(managedgrafana.WorkspaceStatusUpdating, managedgrafana.WorkspaceStatusVersionUpdating).
+		Target(managedgrafana.WorkspaceStatusActive).
+		Run(ctx, timeout)
 }
 
 func waitWorkspaceDeleted(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{managedgrafana.WorkspaceStatusDeleting},
-		Target:  []string{},
-		Refresh: statusWorkspaceStatus(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
-		return output, err
-	}
-
-	return nil, err
+	return retry.Waiter(workspaceFinder(conn, id), workspaceStatus).
+		Pending(managedgrafana.WorkspaceStatusDeleting).
+		Run(ctx, timeout)
 }
 
 func waitLicenseAssociationCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{managedgrafana.WorkspaceStatusUpgrading},
-		Target:  []string{managedgrafana.WorkspaceStatusActive},
-		Refresh: statusWorkspaceStatus(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
-		return output, err
-	}
-
-	return nil, err
+	return retry.Waiter(workspaceFinder(conn, id), workspaceStatus).
+		Pending(managedgrafana.WorkspaceStatusUpgrading).
+		Target(managedgrafana.WorkspaceStatusActive).
+		Run(ctx, timeout)
 }
 
 func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.SamlAuthentication, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{managedgrafana.SamlConfigurationStatusNotConfigured},
-		Target:  []string{managedgrafana.SamlConfigurationStatusConfigured},
-		Refresh: statusWorkspaceSAMLConfiguration(ctx, conn, id),
-		Timeout: timeout,
+	find := func(ctx context.Context) (*managedgrafana.SamlAuthentication, error) {
+		return FindSamlConfigurationByID(ctx, conn, id)
 	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*managedgrafana.SamlAuthentication); ok {
-		return output, err
+	status := func(v *managedgrafana.SamlAuthentication) string {
+		return aws.StringValue(v.Status)
 	}
 
-	return nil, err
+	return retry.Waiter(find, status).
+		Pending(managedgrafana.SamlConfigurationStatusNotConfigured).
+		Target(managedgrafana.SamlConfigurationStatusConfigured).
+		Run(ctx, timeout)
 }
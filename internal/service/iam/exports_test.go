@@ -16,6 +16,7 @@ var (
 	ResourceOpenIDConnectProvider     = resourceOpenIDConnectProvider
 	ResourcePolicy                    = resourcePolicy
 	ResourcePolicyAttachment          = resourcePolicyAttachment
+	ResourcePolicyChunks              = resourcePolicyChunks
 	ResourceRolePolicy                = resourceRolePolicy
 	ResourceRolePolicyAttachment      = resourceRolePolicyAttachment
 	ResourceSAMLProvider              = resourceSAMLProvider
@@ -31,6 +32,7 @@ var (
 	ResourceUserSSHKey                = resourceUserSSHKey
 	ResourceVirtualMFADevice          = resourceVirtualMFADevice
 
+	ChunkPolicyDocument                 = chunkPolicyDocument
 	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
 	FindAccountPasswordPolicy           = findAccountPasswordPolicy
 	FindAttachedGroupPolicies           = findAttachedGroupPolicies
@@ -257,15 +257,23 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	// Delete non-default policy versions.
-	versions, err := findPolicyVersionsByARN(ctx, conn, d.Id())
+	if err := deletePolicy(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+// deletePolicy deletes the specified policy's non-default versions, then the policy itself.
+func deletePolicy(ctx context.Context, conn *iam.Client, arn string) error {
+	versions, err := findPolicyVersionsByARN(ctx, conn, arn)
 
 	if tfresource.NotFound(err) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) versions: %s", d.Id(), err)
+		return fmt.Errorf("reading IAM Policy (%s) versions: %w", arn, err)
 	}
 
 	for _, version := range versions {
@@ -273,25 +281,25 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 			continue
 		}
 
-		if err := policyDeleteVersion(ctx, conn, d.Id(), aws.ToString(version.VersionId)); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+		if err := policyDeleteVersion(ctx, conn, arn, aws.ToString(version.VersionId)); err != nil {
+			return err
 		}
 	}
 
-	log.Printf("[INFO] Deleting IAM Policy: %s", d.Id())
+	log.Printf("[INFO] Deleting IAM Policy: %s", arn)
 	_, err = conn.DeletePolicy(ctx, &iam.DeletePolicyInput{
-		PolicyArn: aws.String(d.Id()),
+		PolicyArn: aws.String(arn),
 	})
 
 	if errs.IsA[*awstypes.NoSuchEntityException](err) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting IAM Policy (%s): %s", d.Id(), err)
+		return fmt.Errorf("deleting IAM Policy (%s): %w", arn, err)
 	}
 
-	return diags
+	return nil
 }
 
 // policyPruneVersions deletes the oldest version.
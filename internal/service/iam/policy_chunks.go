@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Managed policies per policy chunks resource, matching the maximum quota of managed policies per role.
+	policyChunksMaxItems = 20
+)
+
+// @SDKResource("aws_iam_policy_chunks", name="Policy Chunks")
+func resourcePolicyChunks() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyChunksCreate,
+		ReadWithoutTimeout:   resourcePolicyChunksRead,
+		UpdateWithoutTimeout: resourcePolicyChunksUpdate,
+		DeleteWithoutTimeout: resourcePolicyChunksDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// Room for the "-<n>" suffix.
+				ValidateFunc: validResourceName(policyNameMaxLen - 3),
+			},
+			names.AttrPath: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: policyChunksMaxItems,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     verify.ValidIAMPolicyJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				},
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourcePolicyChunksCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	groups, roles, users := expandPolicyChunksEntities(d)
+	var arns []string
+
+	for i, v := range d.Get("policies").([]interface{}) {
+		arn, err := policyChunkCreate(ctx, conn, d, i, v.(string))
+
+		if err != nil {
+			// Keep track of what was created so that it can be cleaned up.
+			if len(arns) > 0 {
+				d.SetId(name)
+				d.Set("arns", arns)
+			}
+
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		arns = append(arns, arn)
+		d.SetId(name)
+		d.Set("arns", arns)
+
+		if err := policyChunkAttach(ctx, conn, arn, groups, roles, users); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourcePolicyChunksRead(ctx, d, meta)...)
+}
+
+func resourcePolicyChunksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	arns := flex.ExpandStringValueList(d.Get("arns").([]interface{}))
+	configured := d.Get("policies").([]interface{})
+	policies := make([]string, len(arns))
+	var groups, roles, users []string
+	var found int
+
+	for i, arn := range arns {
+		policy, err := findPolicyByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			// An empty document forces the missing policy to be recreated.
+			log.Printf("[WARN] IAM Policy (%s) not found", arn)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s): %s", arn, err)
+		}
+
+		found++
+
+		version, err := findPolicyVersion(ctx, conn, arn, aws.ToString(policy.DefaultVersionId))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) default version: %s", arn, err)
+		}
+
+		policyDocument, err := url.QueryUnescape(aws.ToString(version.Document))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing IAM Policy (%s) document: %s", arn, err)
+		}
+
+		var existing string
+		if i < len(configured) && configured[i] != nil {
+			existing = configured[i].(string)
+		}
+
+		policies[i], err = verify.PolicyToSet(existing, policyDocument)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policies[i], err)
+		}
+
+		// Only entities that all of the policies are attached to are reported as attached.
+		g, r, u, err := findEntitiesForPolicyByARN(ctx, conn, arn)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) attachments: %s", arn, err)
+		}
+
+		if found == 1 {
+			groups, roles, users = g, r, u
+		} else {
+			groups, roles, users = intersectStrings(groups, g), intersectStrings(roles, r), intersectStrings(users, u)
+		}
+	}
+
+	if !d.IsNewResource() && found == 0 {
+		log.Printf("[WARN] IAM Policy Chunks (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("groups", groups)
+	d.Set(names.AttrName, d.Id())
+	d.Set("policies", policies)
+	d.Set("roles", roles)
+	d.Set("users", users)
+
+	return diags
+}
+
+func resourcePolicyChunksUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	arns := flex.ExpandStringValueList(d.Get("arns").([]interface{}))
+	o, n := d.GetChange("policies")
+	oldPolicies, newPolicies := o.([]interface{}), n.([]interface{})
+	groups, roles, users := expandPolicyChunksEntities(d)
+
+	// Update the attachments of the policies that are being kept.
+	if d.HasChanges("groups", "roles", "users") {
+		addGroups, delGroups := policyChunksEntitiesChange(d, "groups")
+		addRoles, delRoles := policyChunksEntitiesChange(d, "roles")
+		addUsers, delUsers := policyChunksEntitiesChange(d, "users")
+
+		for i := 0; i < len(arns) && i < len(newPolicies); i++ {
+			if err := policyChunkDetach(ctx, conn, arns[i], delGroups, delRoles, delUsers); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := policyChunkAttach(ctx, conn, arns[i], addGroups, addRoles, addUsers); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	// Update changed documents and create any new policies before removing surplus ones,
+	// so that statements moving between documents are not left out for longer than necessary.
+	for i, v := range newPolicies {
+		policy := v.(string)
+
+		if i < len(arns) {
+			if i < len(oldPolicies) && oldPolicies[i] != nil && verify.PolicyStringsEquivalent(oldPolicies[i].(string), policy) {
+				continue
+			}
+
+			_, err := findPolicyByARN(ctx, conn, arns[i])
+
+			switch {
+			case tfresource.NotFound(err):
+				// Recreate the missing policy.
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s): %s", arns[i], err)
+			default:
+				if err := policyChunkUpdate(ctx, conn, arns[i], policy); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+
+				continue
+			}
+		}
+
+		arn, err := policyChunkCreate(ctx, conn, d, i, policy)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if i < len(arns) {
+			arns[i] = arn
+		} else {
+			arns = append(arns, arn)
+		}
+		d.Set("arns", arns)
+
+		if err := policyChunkAttach(ctx, conn, arn, groups, roles, users); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if len(arns) > len(newPolicies) {
+		oldGroups, oldRoles, oldUsers := policyChunksOldEntities(d)
+
+		for len(arns) > len(newPolicies) {
+			arn := arns[len(arns)-1]
+
+			if err := policyChunkDetach(ctx, conn, arn, oldGroups, oldRoles, oldUsers); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := deletePolicy(ctx, conn, arn); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			arns = arns[:len(arns)-1]
+			d.Set("arns", arns)
+		}
+	}
+
+	return append(diags, resourcePolicyChunksRead(ctx, d, meta)...)
+}
+
+func resourcePolicyChunksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	groups, roles, users := expandPolicyChunksEntities(d)
+
+	for _, arn := range flex.ExpandStringValueList(d.Get("arns").([]interface{})) {
+		if err := policyChunkDetach(ctx, conn, arn, groups, roles, users); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := deletePolicy(ctx, conn, arn); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+// policyChunkName returns the name of the policy holding the document at index i.
+func policyChunkName(name string, i int) string {
+	return fmt.Sprintf("%s-%d", name, i+1)
+}
+
+func policyChunkCreate(ctx context.Context, conn *iam.Client, d *schema.ResourceData, i int, policy string) (string, error) {
+	name := policyChunkName(d.Get(names.AttrName).(string), i)
+
+	policy, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", name, err)
+	}
+
+	input := &iam.CreatePolicyInput{
+		Path:           aws.String(d.Get(names.AttrPath).(string)),
+		PolicyDocument: aws.String(policy),
+		PolicyName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("creating IAM Policy (%s): %w", name, err)
+	}
+
+	return aws.ToString(output.Policy.Arn), nil
+}
+
+func policyChunkUpdate(ctx context.Context, conn *iam.Client, arn, policy string) error {
+	if err := policyPruneVersions(ctx, conn, arn); err != nil {
+		return err
+	}
+
+	policy, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", arn, err)
+	}
+
+	input := &iam.CreatePolicyVersionInput{
+		PolicyArn:      aws.String(arn),
+		PolicyDocument: aws.String(policy),
+		SetAsDefault:   true,
+	}
+
+	_, err = conn.CreatePolicyVersion(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating IAM Policy (%s): %w", arn, err)
+	}
+
+	return nil
+}
+
+func policyChunkAttach(ctx context.Context, conn *iam.Client, arn string, groups, roles, users []string) error {
+	return errors.Join(
+		attachPolicyToGroups(ctx, conn, groups, arn),
+		attachPolicyToRoles(ctx, conn, roles, arn),
+		attachPolicyToUsers(ctx, conn, users, arn),
+	)
+}
+
+func policyChunkDetach(ctx context.Context, conn *iam.Client, arn string, groups, roles, users []string) error {
+	return errors.Join(
+		detachPolicyFromGroups(ctx, conn, groups, arn),
+		detachPolicyFromRoles(ctx, conn, roles, arn),
+		detachPolicyFromUsers(ctx, conn, users, arn),
+	)
+}
+
+func expandPolicyChunksEntities(d *schema.ResourceData) ([]string, []string, []string) {
+	return flex.ExpandStringValueSet(d.Get("groups").(*schema.Set)),
+		flex.ExpandStringValueSet(d.Get("roles").(*schema.Set)),
+		flex.ExpandStringValueSet(d.Get("users").(*schema.Set))
+}
+
+func policyChunksOldEntities(d *schema.ResourceData) ([]string, []string, []string) {
+	g, _ := d.GetChange("groups")
+	r, _ := d.GetChange("roles")
+	u, _ := d.GetChange("users")
+
+	return flex.ExpandStringValueSet(g.(*schema.Set)),
+		flex.ExpandStringValueSet(r.(*schema.Set)),
+		flex.ExpandStringValueSet(u.(*schema.Set))
+}
+
+func policyChunksEntitiesChange(d *schema.ResourceData, key string) ([]string, []string) {
+	o, n := d.GetChange(key)
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	return flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+}
+
+func intersectStrings(s1, s2 []string) []string {
+	return tfslices.Filter(s1, func(v string) bool {
+		return slices.Contains(s2, v)
+	})
+}
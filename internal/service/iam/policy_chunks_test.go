@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyChunks_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy_chunks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyChunksDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyChunksConfig_basic(rName, 1000, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyChunksExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "arns.#", acctest.Ct3),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "arns.0", "iam", fmt.Sprintf("policy/%s-1", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", acctest.Ct1),
+					testAccCheckRolePolicyAttachmentCount(ctx, rName+"-0", 3),
+				),
+			},
+			{
+				Config: testAccPolicyChunksConfig_basic(rName, 6144, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyChunksExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", acctest.Ct2),
+					testAccCheckRolePolicyAttachmentCount(ctx, rName+"-0", 1),
+					testAccCheckRolePolicyAttachmentCount(ctx, rName+"-1", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyChunks_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy_chunks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyChunksDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyChunksConfig_basic(rName, 1000, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyChunksExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiam.ResourcePolicyChunks(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyChunksDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iam_policy_chunks" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if k == "arns.#" || len(k) < 5 || k[:5] != "arns." {
+					continue
+				}
+
+				_, err := tfiam.FindPolicyByARN(ctx, conn, v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("IAM Policy %s still exists", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyChunksExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if k == "arns.#" || len(k) < 5 || k[:5] != "arns." {
+				continue
+			}
+
+			if _, err := tfiam.FindPolicyByARN(ctx, conn, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyChunksConfig_basic(rName string, maxLength, roleCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  dynamic "statement" {
+    for_each = range(12)
+
+    content {
+      sid       = "Statement${statement.value}"
+      actions   = ["s3:GetObject", "s3:PutObject"]
+      resources = ["arn:${data.aws_partition.current.partition}:s3:::bucket-${statement.value}-with-a-fairly-long-name/*"]
+    }
+  }
+}
+
+data "aws_iam_policy_document_chunks" "test" {
+  source_policy_documents = [data.aws_iam_policy_document.test.json]
+  max_length              = %[2]d
+}
+
+resource "aws_iam_role" "test" {
+  count = %[3]d
+
+  name = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_policy_chunks" "test" {
+  name     = %[1]q
+  policies = data.aws_iam_policy_document_chunks.test.chunks
+  roles    = aws_iam_role.test[*].name
+}
+`, rName, maxLength, roleCount)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum size of a managed policy document, in characters excluding whitespace.
+	managedPolicyDocumentMaxLength = 6144
+	// Maximum aggregate size of a role's inline policy documents.
+	roleInlinePolicyDocumentsMaxLength = 10240
+)
+
+// @SDKDataSource("aws_iam_policy_document_chunks", name="Policy Document Chunks")
+func dataSourcePolicyDocumentChunks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyDocumentChunksRead,
+
+		Schema: map[string]*schema.Schema{
+			"chunks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      managedPolicyDocumentMaxLength,
+				ValidateFunc: validation.IntBetween(1, roleInlinePolicyDocumentsMaxLength),
+			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyDocumentChunksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mergedDoc := &IAMPolicyDoc{}
+
+	for i, v := range d.Get("source_policy_documents").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		sourceDoc := &IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(v.(string)), sourceDoc); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Policy Document Chunks: source document %d: %s", i, err)
+		}
+
+		mergedDoc.Merge(sourceDoc)
+	}
+
+	chunks, err := chunkPolicyDocument(mergedDoc, d.Get("max_length").(int))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policy Document Chunks: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(chunks, "\n"))))
+	d.Set("chunks", chunks)
+
+	return diags
+}
+
+// chunkPolicyDocument packs the statements of doc into the fewest minified
+// policy documents no longer than maxLength characters, using first-fit
+// decreasing bin packing. Each chunk keeps the document's Version and Id, and
+// statements keep their original relative order within a chunk.
+func chunkPolicyDocument(doc *IAMPolicyDoc, maxLength int) ([]string, error) {
+	type statement struct {
+		index  int
+		length int
+	}
+	type chunk struct {
+		indices []int
+		length  int
+	}
+
+	// Length of a chunk without any statements, plus the "[]" of the statement array.
+	emptyDoc, err := json.Marshal(&IAMPolicyDoc{Version: doc.Version, Id: doc.Id})
+	if err != nil {
+		return nil, err
+	}
+	baseLength := len(emptyDoc) + len(`,"Statement":[]`)
+
+	statements := make([]statement, len(doc.Statements))
+	for i, v := range doc.Statements {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := baseLength + len(b); n > maxLength {
+			return nil, fmt.Errorf("statement %d (%s) is %d characters as a policy document, exceeding the maximum of %d", i, v.Sid, n, maxLength)
+		}
+
+		statements[i] = statement{index: i, length: len(b)}
+	}
+
+	sort.SliceStable(statements, func(i, j int) bool {
+		return statements[i].length > statements[j].length
+	})
+
+	var chunks []*chunk
+	for _, s := range statements {
+		var target *chunk
+		for _, c := range chunks {
+			// Statements after the first are separated by a comma.
+			if c.length+1+s.length <= maxLength {
+				target = c
+				break
+			}
+		}
+
+		if target == nil {
+			target = &chunk{length: baseLength - 1}
+			chunks = append(chunks, target)
+		}
+
+		target.indices = append(target.indices, s.index)
+		target.length += 1 + s.length
+	}
+
+	output := make([]string, 0, len(chunks))
+	for _, c := range chunks {
+		sort.Ints(c.indices)
+
+		chunkDoc := &IAMPolicyDoc{
+			Version: doc.Version,
+			Id:      doc.Id,
+		}
+		for _, i := range c.indices {
+			chunkDoc.Statements = append(chunkDoc.Statements, doc.Statements[i])
+		}
+
+		b, err := json.Marshal(chunkDoc)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, string(b))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestChunkPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	statement := func(sid string, n int) *tfiam.IAMPolicyStatement {
+		return &tfiam.IAMPolicyStatement{
+			Sid:       sid,
+			Effect:    "Allow",
+			Actions:   "s3:GetObject",
+			Resources: fmt.Sprintf("arn:aws:s3:::%s/*", strings.Repeat("x", n)), //lintignore:AWSAT005
+		}
+	}
+
+	testCases := map[string]struct {
+		doc       *tfiam.IAMPolicyDoc
+		maxLength int
+		wantSids  [][]string
+		wantErr   bool
+	}{
+		"empty": {
+			doc:       &tfiam.IAMPolicyDoc{Version: "2012-10-17"},
+			maxLength: 6144,
+		},
+		"fits": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version:    "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{statement("A", 10), statement("B", 10)},
+			},
+			maxLength: 6144,
+			wantSids:  [][]string{{"A", "B"}},
+		},
+		"first fit decreasing": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version: "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{
+					statement("A", 100),
+					statement("B", 300),
+					statement("C", 200),
+					statement("D", 150),
+				},
+			},
+			maxLength: 500,
+			// No two of B, C and D fit together, and A only fits alongside the smallest of them.
+			// Statements keep their original order within each chunk.
+			wantSids: [][]string{{"B"}, {"C"}, {"A", "D"}},
+		},
+		"statement too large": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version:    "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{statement("A", 10), statement("B", 1000)},
+			},
+			maxLength: 500,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chunks, err := tfiam.ChunkPolicyDocument(testCase.doc, testCase.maxLength)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ChunkPolicyDocument() err %t, want %t: %s", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := len(chunks), len(testCase.wantSids); got != want {
+				t.Fatalf("ChunkPolicyDocument() returned %d chunks, want %d: %v", got, want, chunks)
+			}
+
+			for i, chunk := range chunks {
+				if got, max := len(chunk), testCase.maxLength; got > max {
+					t.Errorf("chunk %d is %d characters, want at most %d", i, got, max)
+				}
+
+				var doc tfiam.IAMPolicyDoc
+				if err := json.Unmarshal([]byte(chunk), &doc); err != nil {
+					t.Fatalf("chunk %d: %s", i, err)
+				}
+
+				if got, want := doc.Version, testCase.doc.Version; got != want {
+					t.Errorf("chunk %d Version = %q, want %q", i, got, want)
+				}
+
+				var sids []string
+				for _, v := range doc.Statements {
+					sids = append(sids, v.Sid)
+				}
+
+				if got, want := strings.Join(sids, ","), strings.Join(testCase.wantSids[i], ","); got != want {
+					t.Errorf("chunk %d Sids = %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAccIAMPolicyDocumentChunksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document_chunks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentChunksDataSourceConfig_basic(6144),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "chunks.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentChunksDataSourceConfig_basic(1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "chunks.#", acctest.Ct3),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentChunksDataSourceConfig_basic(100),
+				ExpectError: regexache.MustCompile(`exceeding the maximum of 100`),
+			},
+		},
+	})
+}
+
+func testAccPolicyDocumentChunksDataSourceConfig_basic(maxLength int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  dynamic "statement" {
+    for_each = range(12)
+
+    content {
+      sid       = "Statement${statement.value}"
+      actions   = ["s3:GetObject", "s3:PutObject"]
+      resources = ["arn:${data.aws_partition.current.partition}:s3:::bucket-${statement.value}-with-a-fairly-long-name/*"]
+    }
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document_chunks" "test" {
+  source_policy_documents = [data.aws_iam_policy_document.test.json]
+  max_length              = %[1]d
+}
+`, maxLength)
+}
@@ -66,6 +66,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
 		},
+		{
+			Factory:  dataSourcePolicyDocumentChunks,
+			TypeName: "aws_iam_policy_document_chunks",
+			Name:     "Policy Document Chunks",
+		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -185,6 +190,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_policy_attachment",
 			Name:     "Policy Attachment",
 		},
+		{
+			Factory:  resourcePolicyChunks,
+			TypeName: "aws_iam_policy_chunks",
+			Name:     "Policy Chunks",
+		},
 		{
 			Factory:  resourceRole,
 			TypeName: "aws_iam_role",
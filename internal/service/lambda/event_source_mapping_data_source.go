@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lambda_event_source_mapping", name="Event Source Mapping")
+func dataSourceEventSourceMapping() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventSourceMappingRead,
+
+		Schema: map[string]*schema.Schema{
+			"amazon_managed_kafka_event_source_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumer_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"batch_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bisect_batch_on_function_error": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"destination_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_failure": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDestinationARN: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"document_db_event_source_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_document": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_source_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrFilter: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pattern": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrFunctionARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_response_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_processing_result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"maximum_batching_window_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"maximum_record_age_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"maximum_retry_attempts": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"parallelization_factor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scaling_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_concurrency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"self_managed_event_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoints: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"self_managed_kafka_event_source_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumer_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_access_configuration": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrURI: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"starting_position": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"starting_position_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_transition_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"topics": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tumbling_window_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	uuid := d.Get("uuid").(string)
+	output, err := findEventSourceMappingByID(ctx, conn, uuid)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Event Source Mapping (%s): %s", uuid, err)
+	}
+
+	d.SetId(aws.ToString(output.UUID))
+	if output.AmazonManagedKafkaEventSourceConfig != nil {
+		if err := d.Set("amazon_managed_kafka_event_source_config", []interface{}{flattenAmazonManagedKafkaEventSourceConfig(output.AmazonManagedKafkaEventSourceConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting amazon_managed_kafka_event_source_config: %s", err)
+		}
+	} else {
+		d.Set("amazon_managed_kafka_event_source_config", nil)
+	}
+	d.Set("batch_size", output.BatchSize)
+	d.Set("bisect_batch_on_function_error", output.BisectBatchOnFunctionError)
+	if output.DestinationConfig != nil {
+		if err := d.Set("destination_config", []interface{}{flattenDestinationConfig(output.DestinationConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting destination_config: %s", err)
+		}
+	} else {
+		d.Set("destination_config", nil)
+	}
+	if output.DocumentDBEventSourceConfig != nil {
+		if err := d.Set("document_db_event_source_config", []interface{}{flattenDocumentDBEventSourceConfig(output.DocumentDBEventSourceConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting document_db_event_source_config: %s", err)
+		}
+	} else {
+		d.Set("document_db_event_source_config", nil)
+	}
+	switch state := aws.ToString(output.State); state {
+	case eventSourceMappingStateEnabled, eventSourceMappingStateEnabling:
+		d.Set(names.AttrEnabled, true)
+	default:
+		d.Set(names.AttrEnabled, false)
+	}
+	d.Set("event_source_arn", output.EventSourceArn)
+	if v := output.FilterCriteria; v != nil {
+		if err := d.Set("filter_criteria", []interface{}{flattenFilterCriteria(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting filter criteria: %s", err)
+		}
+	} else {
+		d.Set("filter_criteria", nil)
+	}
+	d.Set(names.AttrFunctionARN, output.FunctionArn)
+	d.Set("function_response_types", output.FunctionResponseTypes)
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.ToTime(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("last_processing_result", output.LastProcessingResult)
+	d.Set("maximum_batching_window_in_seconds", output.MaximumBatchingWindowInSeconds)
+	d.Set("maximum_record_age_in_seconds", output.MaximumRecordAgeInSeconds)
+	d.Set("maximum_retry_attempts", output.MaximumRetryAttempts)
+	d.Set("parallelization_factor", output.ParallelizationFactor)
+	d.Set("queues", output.Queues)
+	if v := output.ScalingConfig; v != nil {
+		if err := d.Set("scaling_config", []interface{}{flattenScalingConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scaling_config: %s", err)
+		}
+	} else {
+		d.Set("scaling_config", nil)
+	}
+	if output.SelfManagedEventSource != nil {
+		if err := d.Set("self_managed_event_source", []interface{}{flattenSelfManagedEventSource(output.SelfManagedEventSource)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting self_managed_event_source: %s", err)
+		}
+	} else {
+		d.Set("self_managed_event_source", nil)
+	}
+	if output.SelfManagedKafkaEventSourceConfig != nil {
+		if err := d.Set("self_managed_kafka_event_source_config", []interface{}{flattenSelfManagedKafkaEventSourceConfig(output.SelfManagedKafkaEventSourceConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting self_managed_kafka_event_source_config: %s", err)
+		}
+	} else {
+		d.Set("self_managed_kafka_event_source_config", nil)
+	}
+	if err := d.Set("source_access_configuration", flattenSourceAccessConfigurations(output.SourceAccessConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_access_configuration: %s", err)
+	}
+	d.Set("starting_position", output.StartingPosition)
+	if output.StartingPositionTimestamp != nil {
+		d.Set("starting_position_timestamp", aws.ToTime(output.StartingPositionTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("starting_position_timestamp", nil)
+	}
+	d.Set(names.AttrState, output.State)
+	d.Set("state_transition_reason", output.StateTransitionReason)
+	d.Set("topics", output.Topics)
+	d.Set("tumbling_window_in_seconds", output.TumblingWindowInSeconds)
+	d.Set("uuid", output.UUID)
+
+	return diags
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lambda_event_source_mappings", name="Event Source Mappings")
+func dataSourceEventSourceMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventSourceMappingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"event_source_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"event_source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrFunctionARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_processing_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_transition_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"uuids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEventSourceMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	input := &lambda.ListEventSourceMappingsInput{}
+
+	if v, ok := d.GetOk("event_source_arn"); ok {
+		input.EventSourceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("function_name"); ok {
+		input.FunctionName = aws.String(v.(string))
+	}
+
+	output, err := findEventSourceMappings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Event Source Mappings: %s", err)
+	}
+
+	var uuids []string
+
+	for _, v := range output {
+		uuids = append(uuids, aws.ToString(v.UUID))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("event_source_mappings", flattenEventSourceMappingConfigurations(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting event_source_mappings: %s", err)
+	}
+	d.Set("uuids", uuids)
+
+	return diags
+}
+
+func findEventSourceMappings(ctx context.Context, conn *lambda.Client, input *lambda.ListEventSourceMappingsInput) ([]awstypes.EventSourceMappingConfiguration, error) {
+	var output []awstypes.EventSourceMappingConfiguration
+
+	pages := lambda.NewListEventSourceMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EventSourceMappings...)
+	}
+
+	return output, nil
+}
+
+func flattenEventSourceMappingConfigurations(apiObjects []awstypes.EventSourceMappingConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"batch_size":              aws.ToInt32(apiObject.BatchSize),
+			"event_source_arn":        aws.ToString(apiObject.EventSourceArn),
+			names.AttrFunctionARN:     aws.ToString(apiObject.FunctionArn),
+			"last_processing_result":  aws.ToString(apiObject.LastProcessingResult),
+			names.AttrState:           aws.ToString(apiObject.State),
+			"state_transition_reason": aws.ToString(apiObject.StateTransitionReason),
+			"uuid":                    aws.ToString(apiObject.UUID),
+		}
+
+		if v := apiObject.LastModified; v != nil {
+			tfMap["last_modified"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
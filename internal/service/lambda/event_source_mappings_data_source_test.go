@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaEventSourceMappingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_event_source_mappings.test"
+	resourceName := "aws_lambda_event_source_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSourceMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventSourceMappingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "event_source_mappings.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_source_mappings.0.event_source_arn", resourceName, "event_source_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_source_mappings.0.function_arn", resourceName, names.AttrFunctionARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_source_mappings.0.uuid", resourceName, "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "uuids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuids.0", resourceName, "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEventSourceMappingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventSourceMappingConfig_sqsBatchSize(rName, "10"), `
+data "aws_lambda_event_source_mappings" "test" {
+  event_source_arn = aws_sqs_queue.test.arn
+  function_name    = aws_lambda_function.test.function_name
+
+  depends_on = [aws_lambda_event_source_mapping.test]
+}
+`)
+}
@@ -1930,6 +1930,70 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+
+	var timeBeforeUpdate time.Time
+
+	writeFile := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("error writing %s: %s", name, err)
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFile("index.js", "exports.handler = async () => 'one';")
+					writeFile("index.test.js", "test();")
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionSourceCodeHashAttribute(&conf, resourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_dir", "source_dir_excludes"},
+			},
+			{
+				// Changes to excluded files don't change the deployment package.
+				PreConfig: func() {
+					writeFile("index.test.js", "test(); test();")
+				},
+				Config:   testAccFunctionConfig_sourceDir(dir, rName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.js", "exports.handler = async () => 'two';")
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionSourceCodeHashAttribute(&conf, resourceName),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	path, zipFile, err := createTempFile("lambda_s3Update")
@@ -2401,6 +2465,18 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+// testAccCheckFunctionSourceCodeHashAttribute checks that the function's code hash matches the source_code_hash attribute.
+func testAccCheckFunctionSourceCodeHashAttribute(function *lambda.GetFunctionOutput, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		return testAccCheckSourceCodeHash(function, rs.Primary.Attributes["source_code_hash"])(s)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -2551,6 +2627,21 @@ resource "aws_lambda_function" "test" {
 `, funcName))
 }
 
+func testAccFunctionConfig_sourceDir(dir, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir          = %[1]q
+  source_dir_excludes = ["**/*.test.js"]
+  function_name       = %[2]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "index.handler"
+  runtime             = "nodejs20.x"
+}
+`, dir, rName))
+}
+
 func testAccFunctionConfig_snapStartEnabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
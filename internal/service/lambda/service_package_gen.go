@@ -38,6 +38,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
 		},
+		{
+			Factory:  dataSourceEventSourceMapping,
+			TypeName: "aws_lambda_event_source_mapping",
+			Name:     "Event Source Mapping",
+		},
+		{
+			Factory:  dataSourceEventSourceMappings,
+			TypeName: "aws_lambda_event_source_mappings",
+			Name:     "Event Source Mappings",
+		},
 		{
 			Factory:  dataSourceFunction,
 			TypeName: "aws_lambda_function",
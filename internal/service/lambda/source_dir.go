@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/fileset"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// The maximum size of a deployment package uploaded directly, rather than via S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	functionZipFileMaxSize = 50 * 1024 * 1024
+)
+
+var (
+	// ZIP entries are timestamped with the earliest time that the ZIP format can represent.
+	sourceDirPackageModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceDirPackage is a deployment package built from a source directory.
+type sourceDirPackage struct {
+	zipFile []byte
+	sha256  []byte
+}
+
+// hash returns the package's base64-encoded SHA-256 hash, the format of the Lambda function's source code hash.
+func (p *sourceDirPackage) hash() string {
+	return base64.StdEncoding.EncodeToString(p.sha256)
+}
+
+// buildSourceDirPackage builds a ZIP deployment package from the files in the specified directory, less excluded files.
+// The package's contents depend only on the paths and contents of the files, and whether they are executable.
+// Timestamps, ownership and other permissions are normalized so that the same files produce the same package on any machine.
+func buildSourceDirPackage(dir string, excludes []string) (*sourceDirPackage, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source directory (%s): %w", dir, err)
+	}
+
+	relativePaths, err := fileset.Files(root, nil, excludes)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", dir, err)
+	}
+
+	if len(relativePaths) == 0 {
+		return nil, fmt.Errorf("source directory (%s) contains no files", dir)
+	}
+
+	b := new(bytes.Buffer)
+	w := zip.NewWriter(b)
+
+	for _, relativePath := range relativePaths {
+		if err := addSourceDirPackageFile(w, filepath.Join(root, filepath.FromSlash(relativePath)), relativePath); err != nil {
+			return nil, fmt.Errorf("adding %s to deployment package: %w", relativePath, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("building deployment package: %w", err)
+	}
+
+	h := sha256.Sum256(b.Bytes())
+
+	return &sourceDirPackage{
+		zipFile: b.Bytes(),
+		sha256:  h[:],
+	}, nil
+}
+
+func addSourceDirPackageFile(w *zip.Writer, filename, name string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var mode os.FileMode = 0o644
+	if info.Mode().Perm()&0o111 != 0 {
+		mode = 0o755
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: sourceDirPackageModified,
+	}
+	header.SetMode(mode)
+
+	fw, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, file)
+
+	return err
+}
+
+// sourceDirFunctionCode returns the function code for a deployment package built from the configured source directory.
+// Packages larger than the direct upload limit are uploaded to the configured S3 bucket.
+func sourceDirFunctionCode(ctx context.Context, d *schema.ResourceData, meta interface{}) (zipFile []byte, s3Bucket, s3Key string, err error) {
+	dir := d.Get("source_dir").(string)
+	pkg, err := buildSourceDirPackage(dir, flex.ExpandStringValueList(d.Get("source_dir_excludes").([]interface{})))
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if len(pkg.zipFile) <= functionZipFileMaxSize {
+		return pkg.zipFile, "", "", nil
+	}
+
+	s3Bucket = d.Get("source_dir_s3_bucket").(string)
+	if s3Bucket == "" {
+		return nil, "", "", fmt.Errorf("deployment package built from source directory (%s) is %d bytes, larger than the %d byte direct upload limit: set source_dir_s3_bucket", dir, len(pkg.zipFile), functionZipFileMaxSize)
+	}
+
+	// Packages are content-addressed so that unchanged packages aren't uploaded again under a new key.
+	s3Key = fmt.Sprintf("%s/%s.zip", d.Get("function_name").(string), hex.EncodeToString(pkg.sha256))
+
+	uploader := manager.NewUploader(meta.(*conns.AWSClient).S3Client(ctx))
+	input := &s3.PutObjectInput{
+		Body:   bytes.NewReader(pkg.zipFile),
+		Bucket: aws.String(s3Bucket),
+		Key:    aws.String(s3Key),
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return nil, "", "", fmt.Errorf("uploading deployment package to S3 Bucket (%s) Object (%s): %w", s3Bucket, s3Key, err)
+	}
+
+	return nil, s3Bucket, s3Key, nil
+}
+
+// updateSourceCodeHashForSourceDir sets the source code hash from the deployment package built from the configured source directory.
+func updateSourceCodeHashForSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("source_dir") && d.Get("source_dir").(string) == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	dir := d.Get("source_dir").(string)
+
+	pkg, err := buildSourceDirPackage(dir, flex.ExpandStringValueList(d.Get("source_dir_excludes").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	if hash := pkg.hash(); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeSourceDirFiles(t *testing.T, dir string, mode os.FileMode, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBuildSourceDirPackage(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":         "exports.handler = async () => 'ok';",
+		"lib/util.js":      "module.exports = {};",
+		"lib/util.test.js": "test();",
+	}
+	excludes := []string{"**/*.test.js"}
+
+	dir1 := t.TempDir()
+	writeSourceDirFiles(t, dir1, 0o600, files)
+
+	// The same files, with different permissions and timestamps.
+	dir2 := t.TempDir()
+	writeSourceDirFiles(t, dir2, 0o664, files)
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), time.Now(), time.Now().Add(-24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg1, err := buildSourceDirPackage(dir1, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkg2, err := buildSourceDirPackage(dir2, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := pkg2.hash(), pkg1.hash(); got != want {
+		t.Errorf("hash = %s, want %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg1.zipFile), int64(len(pkg1.zipFile)))
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if got, want := f.Mode(), os.FileMode(0o644); got != want {
+			t.Errorf("%s: mode = %s, want %s", f.Name, got, want)
+		}
+
+		if got, want := f.Modified.UTC(), sourceDirPackageModified; !got.Equal(want) {
+			t.Errorf("%s: modified = %s, want %s", f.Name, got, want)
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"index.js", "lib/util.js"}); diff != "" {
+		t.Errorf("unexpected files (+wanted, -got): %s", diff)
+	}
+
+	// Changed content changes the hash.
+	writeSourceDirFiles(t, dir2, 0o644, map[string]string{"index.js": "exports.handler = async () => 'changed';"})
+
+	pkg3, err := buildSourceDirPackage(dir2, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg3.hash() == pkg1.hash() {
+		t.Error("expected hash to change")
+	}
+}
+
+func TestBuildSourceDirPackage_executable(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFiles(t, dir, 0o700, map[string]string{"bootstrap": "#!/bin/sh"})
+
+	pkg, err := buildSourceDirPackage(dir, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg.zipFile), int64(len(pkg.zipFile)))
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	if got, want := r.File[0].Mode(), os.FileMode(0o755); got != want {
+		t.Errorf("mode = %s, want %s", got, want)
+	}
+}
+
+func TestBuildSourceDirPackage_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := buildSourceDirPackage(t.TempDir(), nil); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,824 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The maximum number of ResourceRecord elements in a ChangeResourceRecordSets request. UPSERT changes count twice.
+	recordsChangeBatchMaxRecords = 1000
+	// The maximum number of characters in the ResourceRecord values of a ChangeResourceRecordSets request. UPSERT changes count twice.
+	recordsChangeBatchMaxValueLength = 32000
+)
+
+// @FrameworkResource(name="Records")
+func newRecordsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recordsResource{}
+
+	return r, nil
+}
+
+type recordsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*recordsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53_records"
+}
+
+func (r *recordsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_overwrite": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"record_set": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[recordSetModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.ResourceRecordSetFailover]()...),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("set_identifier")),
+							},
+						},
+						"health_check_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"multivalue_answer": schema.BoolAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"records": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ttl")),
+							},
+						},
+						names.AttrRegion: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.ResourceRecordSetRegion]()...),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("set_identifier")),
+							},
+						},
+						"set_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.RRType]()...),
+							},
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("set_identifier")),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAlias: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recordSetAliasModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"evaluate_target_health": schema.BoolAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+									"zone_id": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 32),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that each record set has exactly one of records or alias.
+// An absent alias block is an empty list, not null, so this can't be done with attribute validators.
+func (r *recordsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data recordsResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RecordSets.IsNull() || data.RecordSets.IsUnknown() {
+		return
+	}
+
+	recordSets, diags := data.RecordSets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range recordSets {
+		if v.Alias.IsUnknown() || v.Records.IsUnknown() {
+			continue
+		}
+
+		alias, records := len(v.Alias.Elements()) > 0, !v.Records.IsNull()
+
+		switch {
+		case alias == records:
+			response.Diagnostics.AddAttributeError(path.Root("record_set"), "Invalid Attribute Combination", fmt.Sprintf("Exactly one of records or alias must be specified for record set %q.", v.Name.ValueString()))
+		case alias && !v.TTL.IsNull():
+			response.Diagnostics.AddAttributeError(path.Root("record_set"), "Invalid Attribute Combination", fmt.Sprintf("ttl can't be specified with alias for record set %q.", v.Name.ValueString()))
+		}
+	}
+}
+
+func (r *recordsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recordsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	recordSets, diags := data.expandRecordSets(ctx, aws.ToString(zone.HostedZone.Name))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Protect existing DNS records which might be managed in another way.
+	action := awstypes.ChangeActionCreate
+	if data.AllowOverwrite.ValueBool() {
+		action = awstypes.ChangeActionUpsert
+	}
+
+	keys := sortedKeys(recordSets)
+	changes := make([]awstypes.Change, 0, len(keys))
+	for _, k := range keys {
+		changes = append(changes, awstypes.Change{
+			Action:            action,
+			ResourceRecordSet: recordSets[k],
+		})
+	}
+
+	data.ID = types.StringValue(zoneID)
+
+	if n, err := changeResourceRecordSets(ctx, conn, zoneID, changes, "Managed by Terraform"); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Records (%s)", zoneID), err.Error())
+
+		if n == 0 {
+			return
+		}
+
+		// Save the record sets in the batches that were applied so that they aren't left in the zone untracked.
+		// The resource is tainted, and replacing it deletes them.
+		applied := make(map[string]struct{}, n)
+		for _, k := range keys[:n] {
+			applied[k] = struct{}{}
+		}
+
+		zoneName := aws.ToString(zone.HostedZone.Name)
+		all, diags := data.RecordSets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.RecordSets, diags = fwtypes.NewSetNestedObjectValueOfSlice(ctx, slices.DeleteFunc(all, func(v *recordSetModel) bool {
+			_, ok := applied[v.identity(zoneName)]
+			return !ok
+		}))
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ID.ValueString())
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	current, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	var newRecordSets []*recordSetModel
+
+	if data.RecordSets.IsNull() {
+		// Import: manage every record set in the zone except the zone apex NS and SOA records.
+		apex := normalizeZoneName(zoneName)
+
+		for _, k := range sortedKeys(current) {
+			v := current[k]
+			if name := normalizeZoneName(cleanRecordName(aws.ToString(v.Name))); name == apex && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa) {
+				continue
+			}
+
+			var recordSet recordSetModel
+			response.Diagnostics.Append(recordSet.flatten(ctx, v, nil)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			newRecordSets = append(newRecordSets, &recordSet)
+		}
+	} else {
+		oldRecordSets, diags := data.RecordSets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, old := range oldRecordSets {
+			v, ok := current[old.identity(zoneName)]
+			if !ok {
+				continue
+			}
+
+			var recordSet recordSetModel
+			response.Diagnostics.Append(recordSet.flatten(ctx, v, old)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			newRecordSets = append(newRecordSets, &recordSet)
+		}
+	}
+
+	if len(newRecordSets) == 0 && !data.RecordSets.IsNull() {
+		tflog.Warn(ctx, "Route 53 Records not found, removing from state", map[string]any{
+			names.AttrID: data.ID.ValueString(),
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	recordSets, diags := fwtypes.NewSetNestedObjectValueOfSlice(ctx, newRecordSets)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.RecordSets = recordSets
+	data.ZoneID = types.StringValue(zoneID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(new.ZoneID.ValueString())
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	oldRecordSets, diags := old.expandRecordSets(ctx, zoneName)
+	response.Diagnostics.Append(diags...)
+	newRecordSets, diags := new.expandRecordSets(ctx, zoneName)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var changes []awstypes.Change
+
+	// Record sets are matched by name, type and set identifier, not by map key, so renaming a key makes no changes.
+	if deletes := slices.DeleteFunc(sortedKeys(oldRecordSets), func(k string) bool {
+		_, ok := newRecordSets[k]
+		return ok
+	}); len(deletes) > 0 {
+		current, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records (%s)", zoneID), err.Error())
+
+			return
+		}
+
+		for _, k := range deletes {
+			// Deleting a record set requires its current values.
+			if v, ok := current[k]; ok {
+				changes = append(changes, awstypes.Change{
+					Action:            awstypes.ChangeActionDelete,
+					ResourceRecordSet: v,
+				})
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(newRecordSets) {
+		v := newRecordSets[k]
+
+		if o, ok := oldRecordSets[k]; !ok {
+			action := awstypes.ChangeActionCreate
+			if new.AllowOverwrite.ValueBool() {
+				action = awstypes.ChangeActionUpsert
+			}
+
+			changes = append(changes, awstypes.Change{
+				Action:            action,
+				ResourceRecordSet: v,
+			})
+		} else if !reflect.DeepEqual(o, v) {
+			changes = append(changes, awstypes.Change{
+				Action:            awstypes.ChangeActionUpsert,
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	if _, err := changeResourceRecordSets(ctx, conn, zoneID, changes, "Managed by Terraform"); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Route 53 Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recordsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ID.ValueString())
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	recordSets, diags := data.expandRecordSets(ctx, aws.ToString(zone.HostedZone.Name))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	var changes []awstypes.Change
+	for _, k := range sortedKeys(recordSets) {
+		// Deleting a record set requires its current values.
+		if v, ok := current[k]; ok {
+			changes = append(changes, awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	tflog.Debug(ctx, "deleting Route 53 Records", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+		"count":      len(changes),
+	})
+
+	_, err = changeResourceRecordSets(ctx, conn, zoneID, changes, "Deleted by Terraform")
+
+	if errs.IsA[*awstypes.NoSuchHostedZone](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 Records (%s)", zoneID), err.Error())
+
+		return
+	}
+}
+
+type recordsResourceModel struct {
+	AllowOverwrite types.Bool                                     `tfsdk:"allow_overwrite"`
+	ID             types.String                                   `tfsdk:"id"`
+	RecordSets     fwtypes.SetNestedObjectValueOf[recordSetModel] `tfsdk:"record_set"`
+	ZoneID         types.String                                   `tfsdk:"zone_id"`
+}
+
+type recordSetModel struct {
+	Alias            fwtypes.ListNestedObjectValueOf[recordSetAliasModel] `tfsdk:"alias"`
+	Failover         types.String                                         `tfsdk:"failover"`
+	HealthCheckID    types.String                                         `tfsdk:"health_check_id"`
+	MultiValueAnswer types.Bool                                           `tfsdk:"multivalue_answer"`
+	Name             types.String                                         `tfsdk:"name"`
+	Records          fwtypes.SetValueOf[types.String]                     `tfsdk:"records"`
+	Region           types.String                                         `tfsdk:"region"`
+	SetIdentifier    types.String                                         `tfsdk:"set_identifier"`
+	TTL              types.Int64                                          `tfsdk:"ttl"`
+	Type             types.String                                         `tfsdk:"type"`
+	Weight           types.Int64                                          `tfsdk:"weight"`
+}
+
+type recordSetAliasModel struct {
+	EvaluateTargetHealth types.Bool   `tfsdk:"evaluate_target_health"`
+	Name                 types.String `tfsdk:"name"`
+	ZoneID               types.String `tfsdk:"zone_id"`
+}
+
+// expandRecordSets returns the configured record sets keyed by their identity in the hosted zone.
+func (data *recordsResourceModel) expandRecordSets(ctx context.Context, zoneName string) (map[string]*awstypes.ResourceRecordSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	recordSets, d := data.RecordSets.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make(map[string]*awstypes.ResourceRecordSet, len(recordSets))
+	for _, recordSet := range recordSets {
+		identity := recordSet.identity(zoneName)
+
+		if _, ok := apiObjects[identity]; ok {
+			diags.AddAttributeError(path.Root("record_set"), "Duplicate record set", fmt.Sprintf("The record set %q is specified more than once.", identity))
+			continue
+		}
+
+		apiObject, d := recordSet.expand(ctx, zoneName)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObjects[identity] = apiObject
+	}
+
+	return apiObjects, diags
+}
+
+// identity returns the record set's unique identifier within the hosted zone: its fully qualified name, type and set identifier.
+func (m *recordSetModel) identity(zoneName string) string {
+	return recordSetIdentity(expandRecordName(m.Name.ValueString(), zoneName), m.Type.ValueString(), m.SetIdentifier.ValueString())
+}
+
+func recordSetIdentity(name, rrType, setIdentifier string) string {
+	vars := []string{
+		strings.ToLower(strings.TrimSuffix(cleanRecordName(name), ".")),
+		strings.ToUpper(rrType),
+	}
+	if setIdentifier != "" {
+		vars = append(vars, setIdentifier)
+	}
+
+	return strings.Join(vars, "_")
+}
+
+func (m *recordSetModel) expand(ctx context.Context, zoneName string) (*awstypes.ResourceRecordSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rrType := awstypes.RRType(m.Type.ValueString())
+	apiObject := &awstypes.ResourceRecordSet{
+		Failover:         awstypes.ResourceRecordSetFailover(m.Failover.ValueString()),
+		HealthCheckId:    fwflex.StringFromFramework(ctx, m.HealthCheckID),
+		MultiValueAnswer: fwflex.BoolFromFramework(ctx, m.MultiValueAnswer),
+		Name:             aws.String(expandRecordName(m.Name.ValueString(), zoneName)),
+		Region:           awstypes.ResourceRecordSetRegion(m.Region.ValueString()),
+		SetIdentifier:    fwflex.StringFromFramework(ctx, m.SetIdentifier),
+		TTL:              fwflex.Int64FromFramework(ctx, m.TTL),
+		Type:             rrType,
+		Weight:           fwflex.Int64FromFramework(ctx, m.Weight),
+	}
+
+	alias, d := m.Alias.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if alias != nil {
+		apiObject.AliasTarget = &awstypes.AliasTarget{
+			DNSName:              fwflex.StringFromFramework(ctx, alias.Name),
+			EvaluateTargetHealth: alias.EvaluateTargetHealth.ValueBool(),
+			HostedZoneId:         fwflex.StringFromFramework(ctx, alias.ZoneID),
+		}
+	}
+
+	if !m.Records.IsNull() {
+		records := fwflex.ExpandFrameworkStringValueSet(ctx, m.Records)
+		// Sort so that unchanged record sets compare equal.
+		slices.Sort(records)
+		apiObject.ResourceRecords = expandResourceRecords(records, rrType)
+	}
+
+	return apiObject, diags
+}
+
+// flatten sets the model from the API object.
+// Values from any prior model are kept where they're equivalent to the API's to avoid spurious differences.
+func (m *recordSetModel) flatten(ctx context.Context, apiObject *awstypes.ResourceRecordSet, prior *recordSetModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if alias := apiObject.AliasTarget; alias != nil {
+		name := normalizeAliasName(aws.ToString(alias.DNSName))
+		if prior != nil {
+			if priorAlias, d := prior.Alias.ToPtr(ctx); !d.HasError() && priorAlias != nil && normalizeAliasName(priorAlias.Name.ValueString()) == name {
+				name = priorAlias.Name.ValueString()
+			}
+		}
+
+		m.Alias, diags = fwtypes.NewListNestedObjectValueOfPtr(ctx, &recordSetAliasModel{
+			EvaluateTargetHealth: types.BoolValue(alias.EvaluateTargetHealth),
+			Name:                 types.StringValue(name),
+			ZoneID:               fwflex.StringToFramework(ctx, alias.HostedZoneId),
+		})
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		// An absent block is an empty list.
+		m.Alias, diags = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []recordSetAliasModel{})
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	m.Failover = fwflex.StringValueToFramework(ctx, apiObject.Failover)
+	m.HealthCheckID = fwflex.StringToFramework(ctx, apiObject.HealthCheckId)
+	m.MultiValueAnswer = fwflex.BoolToFramework(ctx, apiObject.MultiValueAnswer)
+	if prior != nil {
+		m.Name = prior.Name
+	} else {
+		m.Name = types.StringValue(normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name))))
+	}
+	if records := flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type); len(records) > 0 {
+		elems := tfslices.ApplyToAll(records, func(v string) attr.Value {
+			return types.StringValue(v)
+		})
+		m.Records, diags = fwtypes.NewSetValueOf[types.String](ctx, elems)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		m.Records = fwtypes.NewSetValueOfNull[types.String](ctx)
+	}
+	m.Region = fwflex.StringValueToFramework(ctx, apiObject.Region)
+	m.SetIdentifier = fwflex.StringToFramework(ctx, apiObject.SetIdentifier)
+	m.TTL = fwflex.Int64ToFramework(ctx, apiObject.TTL)
+	m.Type = fwflex.StringValueToFramework(ctx, apiObject.Type)
+	m.Weight = fwflex.Int64ToFramework(ctx, apiObject.Weight)
+
+	return diags
+}
+
+// findResourceRecordSetsByZoneID returns all the record sets in the hosted zone keyed by their identity.
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Client, zoneID string) (map[string]*awstypes.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets := make(map[string]*awstypes.ResourceRecordSet, len(output))
+	for _, v := range output {
+		recordSets[recordSetIdentity(aws.ToString(v.Name), string(v.Type), aws.ToString(v.SetIdentifier))] = &v
+	}
+
+	return recordSets, nil
+}
+
+// changeResourceRecordSets submits the changes in batches and then waits for all of them to be applied.
+// It returns the number of changes that were submitted, which on error may be fewer than all of them.
+func changeResourceRecordSets(ctx context.Context, conn *route53.Client, zoneID string, changes []awstypes.Change, comment string) (int, error) {
+	var n int
+	var changeIDs []string
+	for _, chunk := range recordChangeBatches(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: chunk,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		n += len(chunk)
+
+		if output.ChangeInfo != nil {
+			changeIDs = append(changeIDs, aws.ToString(output.ChangeInfo.Id))
+		}
+	}
+
+	// All batches are submitted before waiting so that they synchronize concurrently.
+	for _, id := range changeIDs {
+		if _, err := waitChangeInsync(ctx, conn, id); err != nil {
+			return n, fmt.Errorf("waiting for Route 53 Change (%s) synchronize: %w", id, err)
+		}
+	}
+
+	return n, nil
+}
+
+// recordChangeBatches splits the changes into batches that are within the ChangeResourceRecordSets request quotas.
+// A change that exceeds the quotas by itself is sent in a batch of its own, for Route 53 to reject.
+func recordChangeBatches(changes []awstypes.Change) [][]awstypes.Change {
+	var batches [][]awstypes.Change
+	var batch []awstypes.Change
+	var records, valueLength int
+
+	for _, change := range changes {
+		n, l := recordChangeSize(change)
+
+		if len(batch) > 0 && (records+n > recordsChangeBatchMaxRecords || valueLength+l > recordsChangeBatchMaxValueLength) {
+			batches = append(batches, batch)
+			batch, records, valueLength = nil, 0, 0
+		}
+
+		batch = append(batch, change)
+		records += n
+		valueLength += l
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// recordChangeSize returns the number of ResourceRecord elements in the change and the total length of their values,
+// as counted against the ChangeResourceRecordSets request quotas.
+func recordChangeSize(change awstypes.Change) (int, int) {
+	var records, valueLength int
+
+	if v := change.ResourceRecordSet; v != nil {
+		records = len(v.ResourceRecords)
+		for _, v := range v.ResourceRecords {
+			valueLength += len(aws.ToString(v.Value))
+		}
+	}
+
+	// Alias records have no ResourceRecord elements. Count them as one so that batches of them are also bounded.
+	records = max(records, 1)
+
+	if change.Action == awstypes.ChangeActionUpsert {
+		records, valueLength = 2*records, 2*valueLength
+	}
+
+	return records, valueLength
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRecordSetIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name, rrType, setIdentifier string
+		expected                    string
+	}{
+		{"www.example.com", "A", "", "www.example.com_A"},
+		{"WWW.Example.com.", "a", "", "www.example.com_A"},
+		{"\\052.example.com.", "CNAME", "", "*.example.com_CNAME"},
+		{"www.example.com", "A", "primary", "www.example.com_A_primary"},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfroute53.RecordSetIdentity(testCase.name, testCase.rrType, testCase.setIdentifier); got != testCase.expected {
+			t.Errorf("RecordSetIdentity(%q, %q, %q) = %q, want %q", testCase.name, testCase.rrType, testCase.setIdentifier, got, testCase.expected)
+		}
+	}
+}
+
+func TestRecordChangeBatches(t *testing.T) {
+	t.Parallel()
+
+	change := func(action awstypes.ChangeAction, values ...string) awstypes.Change {
+		return awstypes.Change{
+			Action: action,
+			ResourceRecordSet: &awstypes.ResourceRecordSet{
+				ResourceRecords: tfslices.ApplyToAll(values, func(v string) awstypes.ResourceRecord {
+					return awstypes.ResourceRecord{Value: aws.String(v)}
+				}),
+			},
+		}
+	}
+	repeat := func(n int, change awstypes.Change) []awstypes.Change {
+		changes := make([]awstypes.Change, n)
+		for i := range changes {
+			changes[i] = change
+		}
+		return changes
+	}
+	values := make([]string, 400)
+	for i := range values {
+		values[i] = "192.0.2.1"
+	}
+
+	testCases := map[string]struct {
+		changes  []awstypes.Change
+		expected []int
+	}{
+		"empty": {},
+		"records": {
+			changes:  repeat(1001, change(awstypes.ChangeActionCreate, "192.0.2.1")),
+			expected: []int{1000, 1},
+		},
+		"upserts count twice": {
+			changes:  repeat(501, change(awstypes.ChangeActionUpsert, "192.0.2.1")),
+			expected: []int{500, 1},
+		},
+		"multiple values": {
+			changes:  repeat(3, change(awstypes.ChangeActionDelete, values...)),
+			expected: []int{2, 1},
+		},
+		"value length": {
+			changes:  repeat(4, change(awstypes.ChangeActionCreate, strings.Repeat("a", 10000))),
+			expected: []int{3, 1},
+		},
+		"oversized change": {
+			changes: []awstypes.Change{
+				change(awstypes.ChangeActionCreate, "192.0.2.1"),
+				change(awstypes.ChangeActionUpsert, strings.Repeat("a", 20000)),
+				change(awstypes.ChangeActionCreate, "192.0.2.1"),
+			},
+			expected: []int{1, 1, 1},
+		},
+		"aliases": {
+			changes:  repeat(1001, awstypes.Change{Action: awstypes.ChangeActionCreate, ResourceRecordSet: &awstypes.ResourceRecordSet{}}),
+			expected: []int{1000, 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfslices.ApplyToAll(tfroute53.RecordChangeBatches(testCase.changes), func(v []awstypes.Change) int {
+				return len(v)
+			})
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("batch sizes = %v, want = %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record_set.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_set.*", map[string]string{
+						names.AttrName: "www",
+						names.AttrType: "A",
+						"records.#":    acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_set.*", map[string]string{
+						names.AttrType: "TXT",
+						"records.#":    acctest.Ct1,
+						"records.0":    "v=spf1 -all",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_set.*", map[string]string{
+						"set_identifier": "blue",
+						names.AttrWeight: "90",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: false, // Imported record set names are fully qualified.
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record_set.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_set.*", map[string]string{
+						names.AttrName: "www",
+						"records.#":    acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_set.*", map[string]string{
+						"set_identifier": "green",
+						names.AttrWeight: "10",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Only the zone apex NS and SOA records remain.
+			if n := len(recordSets); n > 2 {
+				return fmt.Errorf("Route 53 Records %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExists(ctx context.Context, n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		// Plus the zone apex NS and SOA records.
+		if got, want := len(recordSets), expected+2; got != want {
+			return fmt.Errorf("Route 53 Records %s: got %d record sets, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record_set {
+    name    = "www"
+    type    = "A"
+    ttl     = 30
+    records = ["127.0.0.1", "127.0.0.27"]
+  }
+
+  record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+
+  record_set {
+    name           = "api"
+    type           = "CNAME"
+    ttl            = 60
+    records        = ["blue.example.com"]
+    set_identifier = "blue"
+    weight         = 90
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record_set {
+    name    = "www"
+    type    = "A"
+    ttl     = 30
+    records = ["127.0.0.1"]
+  }
+
+  record_set {
+    name           = "api"
+    type           = "CNAME"
+    ttl            = 60
+    records        = ["blue.example.com"]
+    set_identifier = "blue"
+    weight         = 90
+  }
+
+  record_set {
+    name           = "api"
+    type           = "CNAME"
+    ttl            = 60
+    records        = ["green.example.com"]
+    set_identifier = "green"
+    weight         = 10
+  }
+}
+`, zoneName)
+}
@@ -24,6 +24,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newCIDRLocationResource,
 		},
+		{
+			Factory: newRecordsResource,
+			Name:    "Records",
+		},
 	}
 }
 
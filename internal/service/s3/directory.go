@@ -0,0 +1,593 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/fileset"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directoryResourceIDPartCount = 2
+	defaultDirectoryContentType  = "application/octet-stream"
+	deleteObjectsMaxKeys         = 1000
+)
+
+// @SDKResource("aws_s3_directory", name="Directory")
+func resourceDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryCreate,
+		ReadWithoutTimeout:   resourceDirectoryRead,
+		UpdateWithoutTimeout: resourceDirectoryUpdate,
+		DeleteWithoutTimeout: resourceDirectoryDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: fileset.ValidatePatternFunc(),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: fileset.ValidatePatternFunc(),
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: fileset.ValidatePatternFunc(),
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := directoryConn(ctx, d, meta)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id, err := flex.FlattenResourceId([]string{bucket, keyPrefix}, directoryResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	files, err := readDirectoryFiles(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := uploadDirectoryFiles(ctx, conn, bucket, files, d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory (%s): %s", id, err)
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		keys, err := findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Directory (%s): %s", id, err)
+		}
+
+		var orphans []string
+		for _, key := range keys {
+			if _, ok := files[key]; !ok {
+				orphans = append(orphans, key)
+			}
+		}
+
+		if err := deleteDirectoryObjects(ctx, conn, bucket, orphans); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Directory (%s): %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+	d.Set("files", flattenDirectoryFiles(files))
+
+	return append(diags, resourceDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := directoryConn(ctx, d, meta)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keys, err := findObjectKeysByPrefix(ctx, conn, bucket, d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	remote := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		remote[key] = struct{}{}
+	}
+
+	files := d.Get("files").(map[string]interface{})
+
+	// Objects deleted outside of Terraform are uploaded again.
+	for key := range files {
+		if _, ok := remote[key]; !ok {
+			delete(files, key)
+		}
+	}
+
+	// Orphaned objects are recorded without a hash so that they are deleted.
+	if d.Get("delete_orphans").(bool) && isDirectoryKeyPrefix(d.Get("key_prefix").(string)) {
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = ""
+			}
+		}
+	}
+
+	d.Set("files", files)
+
+	return diags
+}
+
+func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := directoryConn(ctx, d, meta)
+
+	bucket := d.Get(names.AttrBucket).(string)
+
+	files, err := readDirectoryFiles(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Upload new and changed files, and files whose metadata has changed.
+	o, _ := d.GetChange("files")
+	oldHashes := o.(map[string]interface{})
+	oldContentTypes, _ := d.GetChange("content_types")
+	oldContentTypesMap := flex.ExpandStringValueMap(oldContentTypes.(map[string]interface{}))
+	oldCacheControl, _ := d.GetChange("cache_control")
+	oldRules := expandDirectoryCacheControlRules(oldCacheControl.([]interface{}))
+
+	changed := make(map[string]directoryFile)
+	for key, file := range files {
+		contentType := directoryObjectContentType(file.relativePath, oldContentTypesMap)
+		cacheControl := directoryObjectCacheControl(file.relativePath, oldRules)
+
+		if v, ok := oldHashes[key]; !ok || v.(string) != file.hash || contentType != file.contentType || cacheControl != file.cacheControl {
+			changed[key] = file
+		}
+	}
+
+	var removed []string
+	for key := range oldHashes {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := uploadDirectoryFiles(ctx, conn, bucket, changed, d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := deleteDirectoryObjects(ctx, conn, bucket, removed); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	d.Set("files", flattenDirectoryFiles(files))
+
+	return append(diags, resourceDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := directoryConn(ctx, d, meta)
+
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[DEBUG] Deleting S3 Directory: %s", d.Id())
+	if err := deleteDirectoryObjects(ctx, conn, d.Get(names.AttrBucket).(string), keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Objects are listed by raw prefix, so a prefix such as "site" would also own "site-backup/".
+	if d.Get("delete_orphans").(bool) && d.NewValueKnown("key_prefix") {
+		if keyPrefix := d.Get("key_prefix").(string); !isDirectoryKeyPrefix(keyPrefix) {
+			return fmt.Errorf(`"key_prefix" (%s) must be empty or end in "/" when "delete_orphans" is true`, keyPrefix)
+		}
+	}
+
+	for _, key := range []string{names.AttrSource, "key_prefix", "include", "exclude", "content_types", "cache_control"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	// Hash the local files at plan time so that changed files show in the plan.
+	files, err := readDirectoryFiles(d)
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	oldHashes := o.(map[string]interface{})
+	newHashes := flattenDirectoryFiles(files)
+
+	if len(oldHashes) == len(newHashes) {
+		equal := true
+		for key, v := range newHashes {
+			if oldHashes[key] != v {
+				equal = false
+				break
+			}
+		}
+
+		if equal {
+			return nil
+		}
+	}
+
+	return d.SetNew("files", newHashes)
+}
+
+// isDirectoryKeyPrefix returns whether the key prefix is empty or ends in "/".
+func isDirectoryKeyPrefix(keyPrefix string) bool {
+	return keyPrefix == "" || strings.HasSuffix(keyPrefix, "/")
+}
+
+func directoryConn(ctx context.Context, d interface{ Get(string) any }, meta interface{}) *s3.Client {
+	if isDirectoryBucket(d.Get(names.AttrBucket).(string)) {
+		return meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return meta.(*conns.AWSClient).S3Client(ctx)
+}
+
+// directoryFile represents a local file in the source directory.
+type directoryFile struct {
+	path         string // Local path.
+	relativePath string // Slash-separated path relative to the source directory.
+	hash         string // Hex-encoded SHA-256 hash of the content.
+	contentType  string
+	cacheControl string
+}
+
+type directoryCacheControlRule struct {
+	pattern string
+	value   string
+}
+
+// readDirectoryFiles returns the files in the source directory that are matched by the include and exclude patterns, keyed by object key.
+func readDirectoryFiles(d interface{ Get(string) any }) (map[string]directoryFile, error) {
+	source := d.Get(names.AttrSource).(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	include := flex.ExpandStringValueList(d.Get("include").([]interface{}))
+	exclude := flex.ExpandStringValueList(d.Get("exclude").([]interface{}))
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	rules := expandDirectoryCacheControlRules(d.Get("cache_control").([]interface{}))
+
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	relativePaths, err := fileset.Files(root, include, exclude)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	files := make(map[string]directoryFile, len(relativePaths))
+	for _, relativePath := range relativePaths {
+		filename := filepath.Join(root, filepath.FromSlash(relativePath))
+
+		hash, err := fileSHA256(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+		}
+
+		files[keyPrefix+relativePath] = directoryFile{
+			path:         filename,
+			relativePath: relativePath,
+			hash:         hash,
+			contentType:  directoryObjectContentType(relativePath, contentTypes),
+			cacheControl: directoryObjectCacheControl(relativePath, rules),
+		}
+	}
+
+	return files, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// directoryObjectContentType returns the Content-Type of the object for the file with the specified relative path.
+// The configured content types, keyed by file extension, take precedence over the built-in MIME types.
+func directoryObjectContentType(relativePath string, contentTypes map[string]string) string {
+	ext := strings.ToLower(path.Ext(relativePath))
+
+	if ext == "" {
+		return defaultDirectoryContentType
+	}
+
+	if v, ok := contentTypes[ext]; ok {
+		return v
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+
+	return defaultDirectoryContentType
+}
+
+// directoryObjectCacheControl returns the Cache-Control of the object for the file with the specified relative path.
+// The first rule whose pattern matches applies.
+func directoryObjectCacheControl(relativePath string, rules []directoryCacheControlRule) string {
+	for _, rule := range rules {
+		if ok, _ := fileset.Match(rule.pattern, relativePath); ok {
+			return rule.value
+		}
+	}
+
+	return ""
+}
+
+func expandDirectoryCacheControlRules(tfList []interface{}) []directoryCacheControlRule {
+	var rules []directoryCacheControlRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, directoryCacheControlRule{
+			pattern: tfMap["pattern"].(string),
+			value:   tfMap[names.AttrValue].(string),
+		})
+	}
+
+	return rules
+}
+
+func flattenDirectoryFiles(files map[string]directoryFile) map[string]interface{} {
+	m := make(map[string]interface{}, len(files))
+
+	for key, file := range files {
+		m[key] = file.hash
+	}
+
+	return m
+}
+
+// uploadDirectoryFiles uploads the specified files concurrently.
+// Large files are uploaded in parts, also concurrently.
+func uploadDirectoryFiles(ctx context.Context, conn *s3.Client, bucket string, files map[string]directoryFile, concurrency int) error {
+	uploader := manager.NewUploader(conn)
+
+	type upload struct {
+		key  string
+		file directoryFile
+	}
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	uploads := make(chan upload)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for v := range uploads {
+				if err := uploadDirectoryFile(ctx, uploader, bucket, v.key, v.file); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for key, file := range files {
+		uploads <- upload{key: key, file: file}
+	}
+	close(uploads)
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func uploadDirectoryFile(ctx context.Context, uploader *manager.Uploader, bucket, key string, file directoryFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer body.Close()
+
+	input := &s3.PutObjectInput{
+		Body:        body,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.contentType),
+		Key:         aws.String(key),
+	}
+
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return nil
+}
+
+// deleteDirectoryObjects deletes the objects with the specified keys, in batches.
+func deleteDirectoryObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	for _, chunk := range tfslices.Chunks(keys, deleteObjectsMaxKeys) {
+		page := &s3.ListObjectsV2Output{
+			Contents: tfslices.ApplyToAll(chunk, func(key string) types.Object {
+				return types.Object{
+					Key: aws.String(key),
+				}
+			}),
+		}
+
+		if _, err := deletePageOfObjects(ctx, conn, bucket, page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findObjectKeysByPrefix returns the keys of all objects whose keys begin with the specified prefix.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	var keys []string
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			keys = append(keys, aws.ToString(v.Key))
+		}
+	}
+
+	return keys, nil
+}
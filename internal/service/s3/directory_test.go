@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectoryObjectContentType(t *testing.T) {
+	t.Parallel()
+
+	contentTypes := map[string]string{
+		".html":        "text/html; charset=utf-8",
+		".wasm":        "application/wasm",
+		".webmanifest": "application/manifest+json",
+	}
+
+	testCases := []struct {
+		relativePath string
+		expected     string
+	}{
+		{"index.html", "text/html; charset=utf-8"},
+		{"INDEX.HTML", "text/html; charset=utf-8"},
+		{"app.wasm", "application/wasm"},
+		{"site.webmanifest", "application/manifest+json"},
+		{"logo.png", "image/png"},
+		{"LICENSE", "application/octet-stream"},
+		{"data.unknown-extension", "application/octet-stream"},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfs3.DirectoryObjectContentType(testCase.relativePath, contentTypes); got != testCase.expected {
+			t.Errorf("DirectoryObjectContentType(%q) = %q, want %q", testCase.relativePath, got, testCase.expected)
+		}
+	}
+}
+
+func TestAccS3Directory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccWriteDirectoryFile(t, source, "index.html", "<html></html>")
+	testAccWriteDirectoryFile(t, source, "assets/app.js", "console.log('v1');")
+	testAccWriteDirectoryFile(t, source, "assets/app.js.map", "{}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/assets/app.js"),
+					testAccCheckDirectoryObject(ctx, rName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectoryObject(ctx, rName, "site/assets/app.js", "text/javascript; charset=utf-8", "max-age=31536000"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteDirectoryFile(t, source, "assets/app.js", "console.log('v2');")
+					if err := os.Remove(filepath.Join(source, "index.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/assets/app.js"),
+					testAccCheckDirectoryObjectNotExists(ctx, rName, "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccWriteDirectoryFile(t, source, "index.html", "<html></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct1),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPutDirectoryObject(ctx, t, rName, "site/orphan.txt")
+					testAccPutDirectoryObject(ctx, t, rName, "outside.txt")
+				},
+				Config: testAccDirectoryConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct1),
+					testAccCheckDirectoryObjectNotExists(ctx, rName, "site/orphan.txt"),
+					testAccCheckDirectoryObject(ctx, rName, "outside.txt", "text/plain", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteOrphansInvalidKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccWriteDirectoryFile(t, source, "index.html", "<html></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryConfig_deleteOrphansKeyPrefix(rName, source, "site"),
+				ExpectError: regexache.MustCompile(`"key_prefix" \(site\) must be empty or end in "/"`),
+			},
+		},
+	})
+}
+
+func testAccPutDirectoryObject(ctx context.Context, t *testing.T, bucket, key string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+	_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:        strings.NewReader(key),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String("text/plain"),
+		Key:         aws.String(key),
+	})
+
+	if err != nil {
+		t.Fatalf("putting S3 Object (%s): %s", key, err)
+	}
+}
+
+func testAccWriteDirectoryFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectoryObject(ctx context.Context, bucket, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type: got %q, want %q", key, got, contentType)
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: got %q, want %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory" {
+				continue
+			}
+
+			output, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			})
+
+			if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Contents) > 0 {
+				return fmt.Errorf("S3 Directory %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+
+  exclude = ["**/*.map"]
+
+  content_types = {
+    ".html" = "text/html; charset=utf-8"
+  }
+
+  cache_control {
+    pattern = "assets/**"
+    value   = "max-age=31536000"
+  }
+
+  cache_control {
+    pattern = "**"
+    value   = "no-cache"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_deleteOrphans(rName, source string) string {
+	return testAccDirectoryConfig_deleteOrphansKeyPrefix(rName, source, "site/")
+}
+
+func testAccDirectoryConfig_deleteOrphansKeyPrefix(rName, source, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = %[3]q
+  source         = %[2]q
+  delete_orphans = true
+}
+`, rName, source, keyPrefix)
+}
@@ -450,6 +450,16 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// moveStateFromBucketObject moves the state of an aws_s3_bucket_object resource to an aws_s3_object resource.
+// The resources have the same ID and attributes, and aws_s3_object's additional arguments are left unset.
+func moveStateFromBucketObject(_ context.Context, sourceSchemaVersion int64, source map[string]any) (map[string]any, error) {
+	if sourceSchemaVersion != 0 {
+		return nil, fmt.Errorf("unsupported aws_s3_bucket_object schema version: %d", sourceSchemaVersion)
+	}
+
+	return source, nil
+}
+
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
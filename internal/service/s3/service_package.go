@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -43,3 +44,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 		},
 	), nil
 }
+
+// StateMovers returns the state movers for resource types that have been replaced by another resource type.
+// The aws_s3_bucket_* resources that replace deprecated aws_s3_bucket arguments have no state movers:
+// a moved block moves a whole resource, and the bucket remains an aws_s3_bucket.
+func (p *servicePackage) StateMovers(context.Context) []*types.ServicePackageStateMover {
+	return []*types.ServicePackageStateMover{
+		{
+			SourceTypeName: "aws_s3_bucket_object",
+			TargetTypeName: "aws_s3_object",
+			Move:           moveStateFromBucketObject,
+		},
+	}
+}
@@ -193,6 +193,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
 		},
+		{
+			Factory:  resourceDirectory,
+			TypeName: "aws_s3_directory",
+			Name:     "Directory",
+		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sesv2_rendered_email_template", name="Rendered Email Template")
+func dataSourceRenderedEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRenderedEmailTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"rendered_template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_data": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsJSON, validation.StringLenBetween(1, 262144)),
+			},
+			"template_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameRenderedEmailTemplate = "Rendered Email Template Data Source"
+)
+
+func dataSourceRenderedEmailTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	name := d.Get("template_name").(string)
+	input := &sesv2.TestRenderEmailTemplateInput{
+		TemplateData: aws.String(d.Get("template_data").(string)),
+		TemplateName: aws.String(name),
+	}
+
+	out, err := conn.TestRenderEmailTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, DSNameRenderedEmailTemplate, name, err)
+	}
+
+	d.SetId(name)
+	d.Set("rendered_template", out.RenderedTemplate)
+
+	return diags
+}
@@ -43,6 +43,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEmailIdentityMailFromAttributes,
 			TypeName: "aws_sesv2_email_identity_mail_from_attributes",
 		},
+		{
+			Factory:  dataSourceRenderedEmailTemplate,
+			TypeName: "aws_sesv2_rendered_email_template",
+			Name:     "Rendered Email Template",
+		},
 	}
 }
 
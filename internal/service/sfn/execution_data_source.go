@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_execution", name="Execution")
+func dataSourceExecution() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceExecutionRead,
+
+		Schema: map[string]*schema.Schema{
+			"cause": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"error": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"execution_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"input": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"map_run_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"map_runs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_counts": mapRunCountsSchema(),
+						"item_counts":      mapRunCountsSchema(),
+						"map_run_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_concurrency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"redrive_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stop_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tolerated_failure_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tolerated_failure_percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"redrive_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"redrive_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_machine_alias_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_machine_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stop_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trace_header": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func mapRunCountsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"aborted": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"failed": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"pending": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"results_written": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"running": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"succeeded": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"timed_out": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"total": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	executionARN := d.Get("execution_arn").(string)
+	output, err := findExecutionByARN(ctx, conn, executionARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions Execution (%s): %s", executionARN, err)
+	}
+
+	var mapRuns []*sfn.DescribeMapRunOutput
+
+	input := &sfn.ListMapRunsInput{
+		ExecutionArn: aws.String(executionARN),
+	}
+
+	err = listMapRunsPages(ctx, conn, input, func(page *sfn.ListMapRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MapRuns {
+			if v == nil {
+				continue
+			}
+
+			mapRun, err := findMapRunByARN(ctx, conn, aws.StringValue(v.MapRunArn))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "reading Step Functions Map Run (%s): %s", aws.StringValue(v.MapRunArn), err)
+				return false
+			}
+
+			mapRuns = append(mapRuns, mapRun)
+		}
+
+		return !lastPage
+	})
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Step Functions Execution (%s) Map Runs: %s", executionARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExecutionArn))
+	d.Set("cause", output.Cause)
+	d.Set("error", output.Error)
+	d.Set("execution_arn", output.ExecutionArn)
+	d.Set("input", output.Input)
+	d.Set("map_run_arn", output.MapRunArn)
+	if err := d.Set("map_runs", flattenMapRuns(mapRuns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting map_runs: %s", err)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set("output", output.Output)
+	d.Set("redrive_count", output.RedriveCount)
+	d.Set("redrive_status", output.RedriveStatus)
+	d.Set("start_date", flattenTime(output.StartDate))
+	d.Set("state_machine_alias_arn", output.StateMachineAliasArn)
+	d.Set("state_machine_arn", output.StateMachineArn)
+	d.Set("state_machine_version_arn", output.StateMachineVersionArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("stop_date", flattenTime(output.StopDate))
+	d.Set("trace_header", output.TraceHeader)
+
+	return diags
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := &sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecutionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeExecutionDoesNotExist) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findMapRunByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeMapRunOutput, error) {
+	input := &sfn.DescribeMapRunInput{
+		MapRunArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeMapRunWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenMapRuns(apiObjects []*sfn.DescribeMapRunOutput) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"map_run_arn":                  aws.StringValue(apiObject.MapRunArn),
+			"max_concurrency":              aws.Int64Value(apiObject.MaxConcurrency),
+			"redrive_count":                aws.Int64Value(apiObject.RedriveCount),
+			"start_date":                   flattenTime(apiObject.StartDate),
+			names.AttrStatus:               aws.StringValue(apiObject.Status),
+			"stop_date":                    flattenTime(apiObject.StopDate),
+			"tolerated_failure_count":      aws.Int64Value(apiObject.ToleratedFailureCount),
+			"tolerated_failure_percentage": aws.Float64Value(apiObject.ToleratedFailurePercentage),
+		}
+
+		if v := apiObject.ExecutionCounts; v != nil {
+			tfMap["execution_counts"] = []interface{}{flattenMapRunCounts(v.Aborted, v.Failed, v.Pending, v.ResultsWritten, v.Running, v.Succeeded, v.TimedOut, v.Total)}
+		}
+
+		if v := apiObject.ItemCounts; v != nil {
+			tfMap["item_counts"] = []interface{}{flattenMapRunCounts(v.Aborted, v.Failed, v.Pending, v.ResultsWritten, v.Running, v.Succeeded, v.TimedOut, v.Total)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMapRunCounts(aborted, failed, pending, resultsWritten, running, succeeded, timedOut, total *int64) map[string]interface{} {
+	return map[string]interface{}{
+		"aborted":         aws.Int64Value(aborted),
+		"failed":          aws.Int64Value(failed),
+		"pending":         aws.Int64Value(pending),
+		"results_written": aws.Int64Value(resultsWritten),
+		"running":         aws.Int64Value(running),
+		"succeeded":       aws.Int64Value(succeeded),
+		"timed_out":       aws.Int64Value(timedOut),
+		"total":           aws.Int64Value(total),
+	}
+}
+
+func flattenTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
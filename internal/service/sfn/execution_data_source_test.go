@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNExecutionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_execution.test"
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					testAccCheckStartExecution(ctx, &sm, rName),
+				),
+			},
+			{
+				Config: testAccExecutionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(dataSourceName, "execution_arn", "states", fmt.Sprintf("execution:%[1]s:%[1]s", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "input", `{"hello":"world"}`),
+					resource.TestCheckResourceAttr(dataSourceName, "map_runs.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "state_machine_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStartExecution(ctx context.Context, sm *sfn.DescribeStateMachineOutput, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn(ctx)
+
+		_, err := conn.StartExecutionWithContext(ctx, &sfn.StartExecutionInput{
+			Input:           aws.String(`{"hello":"world"}`),
+			Name:            aws.String(name),
+			StateMachineArn: sm.StateMachineArn,
+		})
+
+		return err
+	}
+}
+
+func testAccExecutionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, 5), `
+data "aws_sfn_executions" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+}
+
+data "aws_sfn_execution" "test" {
+  execution_arn = data.aws_sfn_executions.test.execution_arns[0]
+}
+`)
+}
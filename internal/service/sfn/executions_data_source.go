@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_executions", name="Executions")
+func dataSourceExecutions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceExecutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"execution_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"map_run_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redrive_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_machine_alias_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_machine_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_machine_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stop_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"map_run_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"map_run_arn", "state_machine_arn"},
+			},
+			"state_machine_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"map_run_arn", "state_machine_arn"},
+			},
+			"status_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(sfn.ExecutionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	input := &sfn.ListExecutionsInput{}
+	var id string
+
+	if v, ok := d.GetOk("map_run_arn"); ok {
+		id = v.(string)
+		input.MapRunArn = aws.String(id)
+	}
+
+	if v, ok := d.GetOk("state_machine_arn"); ok {
+		id = v.(string)
+		input.StateMachineArn = aws.String(id)
+	}
+
+	if v, ok := d.GetOk("status_filter"); ok {
+		input.StatusFilter = aws.String(v.(string))
+	}
+
+	var executions []*sfn.ExecutionListItem
+
+	err := listExecutionsPages(ctx, conn, input, func(page *sfn.ListExecutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Executions {
+			if v != nil {
+				executions = append(executions, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Step Functions Executions (%s): %s", id, err)
+	}
+
+	var executionARNs []string
+
+	for _, v := range executions {
+		executionARNs = append(executionARNs, aws.StringValue(v.ExecutionArn))
+	}
+
+	d.SetId(id)
+	d.Set("execution_arns", executionARNs)
+	if err := d.Set("executions", flattenExecutionListItems(executions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting executions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenExecutionListItems(apiObjects []*sfn.ExecutionListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"execution_arn":             aws.StringValue(apiObject.ExecutionArn),
+			"map_run_arn":               aws.StringValue(apiObject.MapRunArn),
+			names.AttrName:              aws.StringValue(apiObject.Name),
+			"redrive_count":             aws.Int64Value(apiObject.RedriveCount),
+			"start_date":                flattenTime(apiObject.StartDate),
+			"state_machine_alias_arn":   aws.StringValue(apiObject.StateMachineAliasArn),
+			"state_machine_arn":         aws.StringValue(apiObject.StateMachineArn),
+			"state_machine_version_arn": aws.StringValue(apiObject.StateMachineVersionArn),
+			names.AttrStatus:            aws.StringValue(apiObject.Status),
+			"stop_date":                 flattenTime(apiObject.StopDate),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNExecutionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_executions.test"
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					testAccCheckStartExecution(ctx, &sm, rName),
+				),
+			},
+			{
+				Config: testAccExecutionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "execution_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "executions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "executions.0.name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "executions.0.state_machine_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "state_machine_arn", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccExecutionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, 5), `
+data "aws_sfn_executions" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+}
+`)
+}
@@ -34,10 +34,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceAlias,
 			TypeName: "aws_sfn_alias",
 		},
+		{
+			Factory:  dataSourceExecution,
+			TypeName: "aws_sfn_execution",
+			Name:     "Execution",
+		},
+		{
+			Factory:  dataSourceExecutions,
+			TypeName: "aws_sfn_executions",
+			Name:     "Executions",
+		},
 		{
 			Factory:  DataSourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 		},
+		{
+			Factory:  dataSourceStateMachineDefinitionValidation,
+			TypeName: "aws_sfn_state_machine_definition_validation",
+			Name:     "State Machine Definition Validation",
+		},
 		{
 			Factory:  DataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
@@ -5,6 +5,7 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/plandiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -162,7 +165,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateStateMachineDefinitionDiff,
+		),
 	}
 }
 
@@ -355,6 +361,50 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// validateStateMachineDefinitionDiff validates a new or changed state machine definition if plan-time validation is enabled.
+// Validation errors fail the plan and warnings are returned as plan warnings.
+func validateStateMachineDefinitionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*conns.AWSClient).PlanTimeValidation {
+		return nil
+	}
+
+	if !d.HasChanges("definition", names.AttrType) || !d.NewValueKnown("definition") || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	output, err := validateStateMachineDefinition(ctx, conn, d.Get("definition").(string), d.Get(names.AttrType).(string))
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	var errs []error
+	for _, v := range output.Diagnostics {
+		diagnostic := fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message))
+		if v := aws.StringValue(v.Location); v != "" {
+			diagnostic = fmt.Sprintf("%s (%s)", diagnostic, v)
+		}
+
+		if aws.StringValue(v.Severity) == sfn.ValidateStateMachineDefinitionSeverityError {
+			errs = append(errs, errors.New(diagnostic))
+		} else {
+			plandiag.AddWarning(ctx, "Step Functions State Machine definition validation", diagnostic)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(errs...))
+	}
+
+	if v := aws.StringValue(output.Result); v != sfn.ValidateStateMachineDefinitionResultCodeOk {
+		return fmt.Errorf("invalid Step Functions State Machine definition: validation result %s", v)
+	}
+
+	return nil
+}
+
 func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
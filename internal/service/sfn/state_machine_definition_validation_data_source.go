@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition_validation", name="State Machine Definition Validation")
+func dataSourceStateMachineDefinitionValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024*1024), // 1048576
+			},
+			"diagnostics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrLocation: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sfn.StateMachineTypeStandard,
+				ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	output, err := validateStateMachineDefinition(ctx, conn, d.Get("definition").(string), d.Get(names.AttrType).(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating Step Functions State Machine definition: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("diagnostics", flattenValidateStateMachineDefinitionDiagnostics(output.Diagnostics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting diagnostics: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
+
+func validateStateMachineDefinition(ctx context.Context, conn *sfn.SFN, definition, stateMachineType string) (*sfn.ValidateStateMachineDefinitionOutput, error) {
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(definition),
+		Type:       aws.String(stateMachineType),
+	}
+
+	return conn.ValidateStateMachineDefinitionWithContext(ctx, input)
+}
+
+func flattenValidateStateMachineDefinitionDiagnostics(apiObjects []*sfn.ValidateStateMachineDefinitionDiagnostic) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"code":             aws.StringValue(apiObject.Code),
+			names.AttrLocation: aws.StringValue(apiObject.Location),
+			names.AttrMessage:  aws.StringValue(apiObject.Message),
+			"severity":         aws.StringValue(apiObject.Severity),
+		})
+	}
+
+	return tfList
+}
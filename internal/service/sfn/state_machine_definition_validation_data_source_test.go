@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionValidationDataSourceConfig_valid,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "diagnostics.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "OK"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "STANDARD"),
+				),
+			},
+			{
+				Config: testAccStateMachineDefinitionValidationDataSourceConfig_invalid,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "diagnostics.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "diagnostics.0.severity", "ERROR"),
+					resource.TestMatchResourceAttr(dataSourceName, "diagnostics.0.message", regexache.MustCompile(`Missing`)),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+				),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionValidationDataSourceConfig_valid = `
+data "aws_sfn_state_machine_definition_validation" "test" {
+  definition = jsonencode({
+    StartAt = "Done"
+    States = {
+      Done = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`
+
+const testAccStateMachineDefinitionValidationDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition_validation" "test" {
+  definition = jsonencode({
+    StartAt = "Missing"
+    States = {
+      Done = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of parameters in a DeleteParameters request.
+	parametersDeleteBatchSize = 10
+	// Interval between PutParameter requests, keeping writes within the default PutParameter throughput quota.
+	parametersPutInterval = 350 * time.Millisecond
+	// Maximum amount of time to retry a PutParameter request that conflicts with a concurrent update.
+	parametersPutTimeout = 2 * time.Minute
+
+	// Key used by SecureString parameters created without a KMS key ID.
+	parameterDefaultKeyID = "alias/aws/ssm"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Schema: map[string]*schema.Schema{
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1011),
+								validation.StringDoesNotMatch(regexache.MustCompile(`^/|/$`), "must not start or end with a slash"),
+							),
+						},
+						"tier": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.ParameterTierStandard,
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterTier](),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.ParameterTypeString,
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterType](),
+						},
+						names.AttrValue: {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			names.AttrPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1011),
+					validation.StringMatch(regexache.MustCompile(`^/`), "must start with a slash"),
+				),
+			},
+		},
+
+		CustomizeDiff: resourceParametersCustomizeDiff,
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Get(names.AttrPath).(string)
+	parameters := expandPutParameterInputs(d.Get("parameter").(*schema.Set).List())
+
+	existing, err := findParametersMetadataByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	var conflicts, unknown []string
+	for _, v := range existing {
+		if _, ok := parameters[parameterRelativeName(path, aws.ToString(v.Name))]; ok {
+			conflicts = append(conflicts, aws.ToString(v.Name))
+		} else {
+			unknown = append(unknown, aws.ToString(v.Name))
+		}
+	}
+
+	if d.Get("exclusive").(bool) {
+		if err := deleteParameters(ctx, conn, unknown); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+		}
+	} else if len(conflicts) > 0 {
+		// Fail before writing anything so that no parameters are left unmanaged.
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): parameters already exist (%s); delete them or set exclusive = true to overwrite them", path, strings.Join(conflicts, ", "))
+	}
+
+	// With exclusive ownership, existing parameters under the path are adopted.
+	if err := putParameters(ctx, conn, path, tfslices.ApplyToAll(d.Get("parameter").(*schema.Set).List(), expandPutParameterInput), d.Get("exclusive").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	d.SetId(path)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	metadata, err := findParametersMetadataByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	// Without exclusive ownership, only parameters already in state are tracked.
+	exclusive := d.Get("exclusive").(bool)
+	prior := expandPutParameterInputs(d.Get("parameter").(*schema.Set).List())
+
+	if !d.IsNewResource() && len(metadata) == 0 && len(prior) > 0 {
+		log.Printf("[WARN] SSM Parameters (%s) not found, removing from state", path)
+		d.SetId("")
+		return diags
+	}
+
+	values, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	valuesByName := make(map[string]string, len(values))
+	for _, v := range values {
+		valuesByName[aws.ToString(v.Name)] = aws.ToString(v.Value)
+	}
+
+	var tfList []interface{}
+
+	for _, v := range metadata {
+		name := parameterRelativeName(path, aws.ToString(v.Name))
+		old, ok := prior[name]
+
+		if !ok && !exclusive {
+			continue
+		}
+
+		value, ok := valuesByName[aws.ToString(v.Name)]
+		if !ok {
+			// Deleted between the two requests.
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrDescription: aws.ToString(v.Description),
+			names.AttrKeyID:       aws.ToString(v.KeyId),
+			names.AttrName:        name,
+			"tier":                string(v.Tier),
+			names.AttrType:        string(v.Type),
+			names.AttrValue:       value,
+		}
+
+		// SecureString parameters created without a key use the AWS managed key.
+		if (old == nil || old.KeyId == nil) && aws.ToString(v.KeyId) == parameterDefaultKeyID {
+			tfMap[names.AttrKeyID] = ""
+		}
+
+		// Intelligent-Tiering resolves to a concrete tier.
+		if old != nil && old.Tier == awstypes.ParameterTierIntelligentTiering {
+			tfMap["tier"] = string(awstypes.ParameterTierIntelligentTiering)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.Set("parameter", tfList)
+	d.Set(names.AttrPath, path)
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		oldParameters, newParameters := expandPutParameterInputs(os.List()), expandPutParameterInputs(ns.List())
+
+		var del []string
+		for name, oldParameter := range oldParameters {
+			newParameter, ok := newParameters[name]
+
+			switch {
+			case !ok:
+				del = append(del, name)
+			case oldParameter.Tier == awstypes.ParameterTierAdvanced && newParameter.Tier == awstypes.ParameterTierStandard:
+				// An advanced parameter can't be downgraded to the standard tier, so recreate it.
+				del = append(del, name)
+			}
+		}
+
+		if err := deleteParameters(ctx, conn, tfslices.ApplyToAll(del, func(v string) string {
+			return parameterFullName(path, v)
+		})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+
+		var put []*ssm.PutParameterInput
+		for _, v := range ns.Difference(os).List() {
+			put = append(put, expandPutParameterInput(v))
+		}
+
+		if err := putParameters(ctx, conn, path, put, true); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	var del []string
+	for name := range expandPutParameterInputs(d.Get("parameter").(*schema.Set).List()) {
+		del = append(del, parameterFullName(path, name))
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", path)
+	if err := deleteParameters(ctx, conn, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", path, err)
+	}
+
+	return diags
+}
+
+// resourceParametersCustomizeDiff rejects configurations with more than one parameter of the same name.
+func resourceParametersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]struct{})
+
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		name := v.(map[string]interface{})[names.AttrName].(string)
+
+		if name == "" {
+			// Unknown.
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("duplicate SSM Parameter name (%s)", name)
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+// putParameters writes the parameters one at a time under path, pacing the requests to stay within
+// the PutParameter throughput quota and retrying those that conflict with concurrent updates.
+func putParameters(ctx context.Context, conn *ssm.Client, path string, inputs []*ssm.PutParameterInput, overwrite bool) error {
+	ticker := time.NewTicker(parametersPutInterval)
+	defer ticker.Stop()
+
+	for i, input := range inputs {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+
+		input.Name = aws.String(parameterFullName(path, aws.ToString(input.Name)))
+		input.Overwrite = aws.Bool(overwrite)
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.TooManyUpdates](ctx, parametersPutTimeout, func() (interface{}, error) {
+			return conn.PutParameter(ctx, input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("putting SSM Parameter (%s): %w", aws.ToString(input.Name), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteParameters deletes the named parameters in batches. Parameters that don't exist are ignored.
+func deleteParameters(ctx context.Context, conn *ssm.Client, parameterNames []string) error {
+	for _, chunk := range tfslices.Chunks(parameterNames, parametersDeleteBatchSize) {
+		input := &ssm.DeleteParametersInput{
+			Names: chunk,
+		}
+
+		output, err := conn.DeleteParameters(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(chunk, ", "), err)
+		}
+
+		if len(output.InvalidParameters) > 0 {
+			log.Printf("[DEBUG] SSM Parameters not found: %s", strings.Join(output.InvalidParameters, ", "))
+		}
+	}
+
+	return nil
+}
+
+func findParametersByPath(ctx context.Context, conn *ssm.Client, path string) ([]awstypes.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	var output []awstypes.Parameter
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Parameters...)
+	}
+
+	return output, nil
+}
+
+func findParametersMetadataByPath(ctx context.Context, conn *ssm.Client, path string) ([]awstypes.ParameterMetadata, error) {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []awstypes.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: []string{path},
+			},
+		},
+	}
+
+	return findParametersMetadata(ctx, conn, input)
+}
+
+// parameterFullName returns the name of the parameter with the relative name under path.
+func parameterFullName(path, name string) string {
+	return strings.TrimSuffix(path, "/") + "/" + name
+}
+
+// parameterRelativeName returns the name of the parameter relative to path.
+func parameterRelativeName(path, name string) string {
+	return strings.TrimPrefix(name, strings.TrimSuffix(path, "/")+"/")
+}
+
+// expandPutParameterInputs returns the configured parameters keyed by relative name.
+func expandPutParameterInputs(tfList []interface{}) map[string]*ssm.PutParameterInput {
+	apiObjects := make(map[string]*ssm.PutParameterInput, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		apiObject := expandPutParameterInput(tfMapRaw)
+		apiObjects[aws.ToString(apiObject.Name)] = apiObject
+	}
+
+	return apiObjects
+}
+
+// expandPutParameterInput returns the PutParameter input for a configured parameter. The name is relative to the path.
+func expandPutParameterInput(tfMapRaw interface{}) *ssm.PutParameterInput {
+	tfMap := tfMapRaw.(map[string]interface{})
+	typ := awstypes.ParameterType(tfMap[names.AttrType].(string))
+
+	apiObject := &ssm.PutParameterInput{
+		Description: aws.String(tfMap[names.AttrDescription].(string)),
+		Name:        aws.String(tfMap[names.AttrName].(string)),
+		Tier:        awstypes.ParameterTier(tfMap["tier"].(string)),
+		Type:        typ,
+		Value:       aws.String(tfMap[names.AttrValue].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrKeyID].(string); ok && v != "" && typ == awstypes.ParameterTypeSecureString {
+		apiObject.KeyId = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+	path := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx, path, "a", "b", "nested/c"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPath, path),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "a",
+						"tier":          "Standard",
+						names.AttrType:  "String",
+						names.AttrValue: "value-a",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrKeyID: "",
+						names.AttrName:  "b",
+						names.AttrType:  "SecureString",
+						names.AttrValue: "value-b",
+					}),
+					testAccCheckParametersValue(ctx, path+"/a", "value-a"),
+					testAccCheckParametersValue(ctx, path+"/b", "value-b"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrDescription: "updated",
+						names.AttrName:        "a",
+						"tier":                "Advanced",
+						names.AttrValue:       "value-a2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "nested/c",
+						names.AttrValue: "value-c",
+					}),
+					testAccCheckParametersValue(ctx, path+"/a", "value-a2"),
+					testAccCheckParametersValue(ctx, path+"/nested/c", "value-c"),
+					testAccCheckParametersNotExist(ctx, path+"/b"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName: "a",
+						"tier":         "Standard",
+					}),
+					testAccCheckParametersNotExist(ctx, path+"/nested/c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_exclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+	path := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx, path, "a", "unmanaged"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_exclusive(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+					testAccCheckParametersPut(ctx, path+"/unmanaged"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccParametersConfig_exclusive(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+					testAccCheckParametersNotExist(ctx, path+"/unmanaged"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_alreadyExists(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+	path := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx, path, "a", "b"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCheckParametersPut(ctx, path+"/a")(nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      testAccParametersConfig_basic(rName),
+				ExpectError: regexache.MustCompile(`parameters already exist`),
+			},
+			{
+				Config: testAccParametersConfig_exclusive(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+					testAccCheckParametersValue(ctx, path+"/a", "value-a"),
+					testAccCheckParametersNotExist(ctx, path+"/b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(ctx context.Context, path string, relativeNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, name := range relativeNames {
+			if err := testAccCheckParametersNotExist(ctx, path+"/"+name)(s); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersNotExist(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := tfssm.FindParameterByName(ctx, conn, name, false)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Parameter %s still exists", name)
+	}
+}
+
+func testAccCheckParametersValue(ctx context.Context, name, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParameterByName(ctx, conn, name, true)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.Value); got != want {
+			return fmt.Errorf("SSM Parameter %s value = %q, want %q", name, got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckParametersPut creates a parameter outside of Terraform.
+func testAccCheckParametersPut(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  awstypes.ParameterTypeString,
+			Value: aws.String("unmanaged"),
+		})
+
+		return err
+	}
+}
+
+func testAccParametersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "a"
+    value = "value-a"
+  }
+
+  parameter {
+    name  = "b"
+    type  = "SecureString"
+    value = "value-b"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name        = "a"
+    description = "updated"
+    tier        = "Advanced"
+    value       = "value-a2"
+  }
+
+  parameter {
+    name  = "nested/c"
+    value = "value-c"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_exclusive(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path      = "/%[1]s"
+  exclusive = true
+
+  parameter {
+    name  = "a"
+    value = "value-a"
+  }
+}
+`, rName)
+}
@@ -50,3 +50,13 @@ type ServicePackageSDKResource struct {
 	Name     string
 	Tags     *ServicePackageResourceTags
 }
+
+// ServicePackageStateMover represents a mapping of a resource type's state to that of another resource type,
+// used when a `moved` block changes the resource type, e.g. from a deprecated resource type to its replacement.
+type ServicePackageStateMover struct {
+	SourceTypeName string
+	TargetTypeName string
+	// Move returns the target resource's state given the source resource's state, decoded from JSON.
+	// Attributes that aren't in the target resource's schema are ignored.
+	Move func(ctx context.Context, sourceSchemaVersion int64, source map[string]any) (map[string]any, error)
+}
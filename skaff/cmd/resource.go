@@ -16,12 +16,28 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	sdkService    string
+	createOp      string
+	readOp        string
+	updateOp      string
+	deleteOp      string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if createOp != "" || readOp != "" || updateOp != "" || deleteOp != "" {
+			ops := resource.Operations{
+				Create: createOp,
+				Read:   readOp,
+				Update: updateOp,
+				Delete: deleteOp,
+			}
+
+			return resource.CreateFromAPI(name, snakeName, sdkService, ops, !clearComments, force, includeTags)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +51,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&sdkService, "sdk-service", "", "AWS SDK for Go v2 service package to generate the resource from (defaults to the service's package)")
+	resourceCmd.Flags().StringVar(&createOp, "create-op", "", "generate from the AWS API: Create operation (e.g., CreateExport)")
+	resourceCmd.Flags().StringVar(&readOp, "read-op", "", "generate from the AWS API: Read operation (e.g., GetExport)")
+	resourceCmd.Flags().StringVar(&updateOp, "update-op", "", "generate from the AWS API: Update operation, if any (e.g., UpdateExport)")
+	resourceCmd.Flags().StringVar(&deleteOp, "delete-op", "", "generate from the AWS API: Delete operation (e.g., DeleteExport)")
+	resourceCmd.MarkFlagsRequiredTogether("create-op", "read-op", "delete-op")
 }
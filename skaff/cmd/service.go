@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	servicePackage string
+	listOps        string
+	skipGenerate   bool
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service package and its first resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(servicePackage, name, snakeName, listOps, !clearComments, force, !pluginSDKV2, includeTags, !skipGenerate)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&servicePackage, "service", "v", "", "service package name, as defined in names/data/names_data.hcl (e.g., bcmdataexports)")
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the first resource")
+	serviceCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give resource name in snake case (e.g., db_vpc_instance)")
+	serviceCmd.Flags().StringVarP(&listOps, "list-ops", "l", "", "comma-separated List API operations to generate list-pages functions for (e.g., ListExports)")
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	serviceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate the first resource for Terraform Plugin SDK V2")
+	serviceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this service supports tagging and the code for tagging should be generated")
+	serviceCmd.Flags().BoolVarP(&skipGenerate, "skip-generate", "g", false, "do not run go generate for the new service package, provider and sweepers")
+	serviceCmd.MarkFlagRequired("service")
+	serviceCmd.MarkFlagRequired("name")
+}
@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed introspect.tmpl
+var introspectTmpl string
+
+// Operations is the set of AWS API operations that implement a resource's lifecycle.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+}
+
+func (o Operations) names() []string {
+	var names []string
+
+	for _, v := range []string{o.Create, o.Read, o.Update, o.Delete} {
+		if v != "" {
+			names = append(names, v)
+		}
+	}
+
+	return names
+}
+
+// apiField describes an AWS SDK for Go v2 API structure member, as discovered by reflection.
+type apiField struct {
+	Name     string     `json:"name,omitempty"`
+	Kind     string     `json:"kind"`
+	TypeName string     `json:"typeName,omitempty"`
+	Elem     *apiField  `json:"elem,omitempty"`
+	Fields   []apiField `json:"fields,omitempty"`
+}
+
+// apiOperation describes an AWS SDK for Go v2 API operation's input and output structures.
+type apiOperation struct {
+	Name   string     `json:"name"`
+	Input  []apiField `json:"input"`
+	Output []apiField `json:"output"`
+}
+
+// introspect runs a throwaway program inside the provider module that reflects over the
+// specified AWS SDK for Go v2 API operations' input and output structures.
+// Reflection must happen in a program that imports the SDK package, which skaff itself does not.
+func introspect(root, goV2Package string, ops Operations) (map[string]apiOperation, error) {
+	dir, err := os.MkdirTemp(root, "skaff-introspect-")
+	if err != nil {
+		return nil, fmt.Errorf("creating introspection directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tplate, err := template.New("introspect").Parse(introspectTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing introspection template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, struct {
+		GoV2Package string
+		Operations  []string
+	}{
+		GoV2Package: goV2Package,
+		Operations:  ops.names(),
+	}); err != nil {
+		return nil, fmt.Errorf("executing introspection template: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), buffer.Bytes(), 0644); err != nil {
+		return nil, fmt.Errorf("writing introspection program: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Dir = root
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running introspection program (do the %s operations exist?): %w\n%s", goV2Package, err, stderr.String())
+	}
+
+	var operations []apiOperation
+	if err := json.Unmarshal(stdout.Bytes(), &operations); err != nil {
+		return nil, fmt.Errorf("reading introspection output: %w", err)
+	}
+
+	output := make(map[string]apiOperation, len(operations))
+	for _, v := range operations {
+		output[v.Name] = v
+	}
+
+	return output, nil
+}
+
+// ModelField is a field of a generated resource model struct and its corresponding schema attribute or block.
+type ModelField struct {
+	FieldName string // Go field name, matching the API member name as AutoFlex expects
+	TFName    string // Terraform attribute or block name
+	ModelType string // Go type of the model field
+	Schema    string // Schema attribute or block definition
+	Block     bool
+}
+
+// ModelStruct is a generated resource model struct.
+type ModelStruct struct {
+	Name   string
+	Fields []ModelField
+}
+
+// Attributes returns the fields defined as schema attributes.
+func (m ModelStruct) Attributes() []ModelField {
+	return slices.DeleteFunc(slices.Clone(m.Fields), func(v ModelField) bool { return v.Block })
+}
+
+// Blocks returns the fields defined as schema blocks.
+func (m ModelStruct) Blocks() []ModelField {
+	return slices.DeleteFunc(slices.Clone(m.Fields), func(v ModelField) bool { return !v.Block })
+}
+
+// APIModel is the resource schema and model derived from an AWS API.
+type APIModel struct {
+	Operations  Operations
+	Model       ModelStruct   // The resource model
+	Nested      []ModelStruct // Models for nested blocks and attributes
+	Unsupported []string      // API members that could not be mapped
+
+	ReadMember     string // Read output member wrapping the resource description, if any
+	ReadResultType string // Go type of the resource description
+	StatusField    string // Resource description member holding the resource's status, if any
+	StatusEnum     string // Enum type of the status member, if any
+}
+
+type fieldMode int
+
+const (
+	fieldModeOptional fieldMode = iota
+	fieldModeOptionalForceNew
+	fieldModeComputed
+)
+
+// skippedAPIFields are API members handled by the provider rather than the resource model.
+var skippedAPIFields = []string{
+	"ClientToken",
+	"ResourceTags",
+	"Tags",
+}
+
+// newAPIModel derives a resource model from the introspected API operations.
+// Create input members are configurable, and force replacement if they cannot be updated.
+// Read output members not in the Create input are computed.
+func newAPIModel(resName, goV2Package string, ops Operations, operations map[string]apiOperation) (*APIModel, error) {
+	create, ok := operations[ops.Create]
+	if !ok {
+		return nil, fmt.Errorf("operation (%s) not found", ops.Create)
+	}
+
+	read, ok := operations[ops.Read]
+	if !ok {
+		return nil, fmt.Errorf("operation (%s) not found", ops.Read)
+	}
+
+	var updatable []string
+	if update, ok := operations[ops.Update]; ok {
+		for _, v := range update.Input {
+			updatable = append(updatable, v.Name)
+		}
+	}
+
+	m := &APIModel{
+		Operations:     ops,
+		ReadResultType: fmt.Sprintf("%s.%sOutput", goV2Package, ops.Read),
+	}
+
+	// Descriptions are frequently wrapped in a single member, e.g. GetExportOutput.Export.
+	readFields := read.Output
+	if len(readFields) == 1 && readFields[0].Kind == "struct" {
+		m.ReadMember = readFields[0].Name
+		m.ReadResultType = "awstypes." + readFields[0].TypeName
+		readFields = readFields[0].Fields
+	}
+
+	for _, v := range readFields {
+		if v.Kind != "string" && v.Kind != "enum" {
+			continue
+		}
+
+		if strings.HasSuffix(v.Name, "Status") || strings.HasSuffix(v.Name, "State") {
+			m.StatusField = v.Name
+			if v.Kind == "enum" {
+				m.StatusEnum = v.TypeName
+			}
+			break
+		}
+	}
+
+	g := modelGenerator{
+		apiModel: m,
+		seen:     make(map[string]bool),
+	}
+
+	var fields []ModelField
+	inputNames := make(map[string]bool)
+
+	for _, v := range create.Input {
+		if slices.Contains(skippedAPIFields, v.Name) {
+			continue
+		}
+		inputNames[v.Name] = true
+
+		mode := fieldModeOptional
+		if !slices.Contains(updatable, v.Name) {
+			mode = fieldModeOptionalForceNew
+		}
+
+		if f, ok := g.field(v, mode); ok {
+			fields = append(fields, f)
+		}
+	}
+
+	for _, v := range readFields {
+		if slices.Contains(skippedAPIFields, v.Name) || inputNames[v.Name] {
+			continue
+		}
+
+		if f, ok := g.field(v, fieldModeComputed); ok {
+			fields = append(fields, f)
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].TFName < fields[j].TFName
+	})
+
+	m.Model = ModelStruct{
+		Name:   fmt.Sprintf("resource%sModel", resName),
+		Fields: fields,
+	}
+
+	sort.Slice(m.Nested, func(i, j int) bool {
+		return m.Nested[i].Name < m.Nested[j].Name
+	})
+
+	return m, nil
+}
+
+type modelGenerator struct {
+	apiModel *APIModel
+	seen     map[string]bool
+}
+
+func (g *modelGenerator) field(v apiField, mode fieldMode) (ModelField, bool) {
+	f := ModelField{
+		FieldName: goFieldName(v.Name),
+		TFName:    convert.ToSnakeCase(v.Name, ""),
+	}
+
+	// The resource's id attribute is always defined.
+	if f.TFName == "id" {
+		return f, false
+	}
+
+	switch v.Kind {
+	case "string", "enum", "bool", "int64", "float64", "timestamp":
+		f.ModelType, f.Schema = primitiveAttribute(v, mode)
+	case "list", "map":
+		if v.Elem.Kind == "struct" && v.Kind == "list" {
+			f.ModelType, f.Schema, f.Block = g.nested(v.Elem, mode, false)
+			break
+		}
+
+		if !isPrimitive(v.Elem.Kind) {
+			g.unsupported(v)
+			return f, false
+		}
+
+		f.ModelType, f.Schema = collectionAttribute(v, mode)
+	case "struct":
+		f.ModelType, f.Schema, f.Block = g.nested(&v, mode, true)
+	default:
+		g.unsupported(v)
+		return f, false
+	}
+
+	return f, true
+}
+
+func (g *modelGenerator) unsupported(v apiField) {
+	g.apiModel.Unsupported = append(g.apiModel.Unsupported, v.Name)
+}
+
+// nested returns the model type and schema for a nested structure, generating its model struct.
+// Configurable structures are blocks. Computed-only structures are attributes as blocks cannot be computed.
+func (g *modelGenerator) nested(v *apiField, mode fieldMode, single bool) (string, string, bool) {
+	name := convert.ToLowercasePrefix(v.TypeName) + "Model"
+
+	var attributes, blocks []string
+	var fields []ModelField
+	for _, e := range v.Fields {
+		childMode := mode
+		if mode == fieldModeOptionalForceNew {
+			// Replacement is triggered by the enclosing block.
+			childMode = fieldModeOptional
+		}
+
+		f, ok := g.field(e, childMode)
+		if !ok {
+			continue
+		}
+		fields = append(fields, f)
+
+		if f.Block {
+			blocks = append(blocks, fmt.Sprintf("%q: %s,", f.TFName, f.Schema))
+		} else {
+			attributes = append(attributes, fmt.Sprintf("%q: %s,", f.TFName, f.Schema))
+		}
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	if !g.seen[name] {
+		g.seen[name] = true
+
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].TFName < fields[j].TFName
+		})
+
+		g.apiModel.Nested = append(g.apiModel.Nested, ModelStruct{
+			Name:   name,
+			Fields: fields,
+		})
+	}
+
+	modelType := fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", name)
+
+	if mode == fieldModeComputed {
+		return modelType, fmt.Sprintf(`schema.ListAttribute{
+	CustomType:  fwtypes.NewListNestedObjectTypeOf[%[1]s](ctx),
+	Computed:    true,
+	ElementType: fwtypes.NewObjectTypeOf[%[1]s](ctx),
+}`, name), false
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "schema.ListNestedBlock{\n\tCustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", name)
+	if mode == fieldModeOptionalForceNew {
+		b.WriteString("\tPlanModifiers: []planmodifier.List{\n\t\tlistplanmodifier.RequiresReplace(),\n\t},\n")
+	}
+	if single {
+		b.WriteString("\tValidators: []validator.List{\n\t\tlistvalidator.SizeAtMost(1),\n\t},\n")
+	}
+	b.WriteString("\tNestedObject: schema.NestedBlockObject{\n")
+	if len(attributes) > 0 {
+		fmt.Fprintf(&b, "\t\tAttributes: map[string]schema.Attribute{\n%s\n},\n", strings.Join(attributes, "\n"))
+	}
+	if len(blocks) > 0 {
+		fmt.Fprintf(&b, "\t\tBlocks: map[string]schema.Block{\n%s\n},\n", strings.Join(blocks, "\n"))
+	}
+	b.WriteString("\t},\n}")
+
+	return modelType, b.String(), true
+}
+
+func isPrimitive(kind string) bool {
+	switch kind {
+	case "string", "enum", "bool", "int64", "float64", "timestamp":
+		return true
+	}
+
+	return false
+}
+
+func primitiveAttribute(v apiField, mode fieldMode) (string, string) {
+	var modelType, schemaType, customType, planModifierType string
+
+	switch v.Kind {
+	case "string":
+		modelType, schemaType, planModifierType = "types.String", "String", "string"
+	case "enum":
+		modelType, schemaType, planModifierType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", v.TypeName), "String", "string"
+		customType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", v.TypeName)
+	case "bool":
+		modelType, schemaType, planModifierType = "types.Bool", "Bool", "bool"
+	case "int64":
+		modelType, schemaType, planModifierType = "types.Int64", "Int64", "int64"
+	case "float64":
+		modelType, schemaType, planModifierType = "types.Float64", "Float64", "float64"
+	case "timestamp":
+		modelType, schemaType, planModifierType = "timetypes.RFC3339", "String", "string"
+		customType = "timetypes.RFC3339Type{}"
+	}
+
+	return modelType, attribute(schemaType, planModifierType, customType, "", mode)
+}
+
+func collectionAttribute(v apiField, mode fieldMode) (string, string) {
+	var modelType, schemaType, customType, elementType, planModifierType string
+
+	if v.Kind == "list" {
+		schemaType, planModifierType = "List", "list"
+	} else {
+		schemaType, planModifierType = "Map", "map"
+	}
+
+	switch v.Elem.Kind {
+	case "string", "enum":
+		if v.Kind == "list" {
+			modelType, customType = "fwtypes.ListValueOf[types.String]", "fwtypes.ListOfStringType"
+		} else {
+			modelType, customType = "fwtypes.MapValueOf[types.String]", "fwtypes.MapOfStringType"
+		}
+		elementType = "types.StringType"
+	case "bool":
+		modelType, elementType = "types."+schemaType, "types.BoolType"
+	case "int64":
+		modelType, elementType = "types."+schemaType, "types.Int64Type"
+	case "float64":
+		modelType, elementType = "types."+schemaType, "types.Float64Type"
+	case "timestamp":
+		modelType, elementType = "types."+schemaType, "timetypes.RFC3339Type{}"
+	}
+
+	return modelType, attribute(schemaType, planModifierType, customType, elementType, mode)
+}
+
+func attribute(schemaType, planModifierType, customType, elementType string, mode fieldMode) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "schema.%sAttribute{\n", schemaType)
+	if customType != "" {
+		fmt.Fprintf(&b, "\tCustomType: %s,\n", customType)
+	}
+	if elementType != "" {
+		fmt.Fprintf(&b, "\tElementType: %s,\n", elementType)
+	}
+
+	switch mode {
+	case fieldModeComputed:
+		b.WriteString("\tComputed: true,\n")
+	case fieldModeOptional:
+		b.WriteString("\tOptional: true,\n")
+	case fieldModeOptionalForceNew:
+		b.WriteString("\tOptional: true,\n")
+		fmt.Fprintf(&b, "\tPlanModifiers: []planmodifier.%s{\n\t\t%splanmodifier.RequiresReplace(),\n\t},\n", schemaType, planModifierType)
+	}
+	b.WriteString("}")
+
+	return b.String()
+}
+
+var initialisms = map[string]string{
+	"Arn":  "ARN",
+	"Arns": "ARNs",
+	"Dns":  "DNS",
+	"Id":   "ID",
+	"Ids":  "IDs",
+	"Ip":   "IP",
+	"Json": "JSON",
+	"Kms":  "KMS",
+	"Sql":  "SQL",
+	"Ssl":  "SSL",
+	"Tls":  "TLS",
+	"Uri":  "URI",
+	"Url":  "URL",
+	"Urls": "URLs",
+	"Vpc":  "VPC",
+}
+
+// goFieldName returns the model struct field name for an API member name.
+// Initialisms are capitalized as the provider's naming conventions require. AutoFlex matches field names case-insensitively.
+func goFieldName(s string) string {
+	var words []string
+	start := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || (s[i] >= 'A' && s[i] <= 'Z' && s[i-1] >= 'a' && s[i-1] <= 'z') {
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+
+	for i, w := range words {
+		if v, ok := initialisms[w]; ok {
+			words[i] = v
+		}
+	}
+
+	return strings.Join(words, "")
+}
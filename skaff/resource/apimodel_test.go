@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewAPIModel(t *testing.T) {
+	t.Parallel()
+
+	ops := Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+	}
+	operations := map[string]apiOperation{
+		"CreateWidget": {
+			Name: "CreateWidget",
+			Input: []apiField{
+				{Name: "ClientToken", Kind: "string"},
+				{Name: "Description", Kind: "string"},
+				{Name: "KmsKeyArn", Kind: "string"},
+				{Name: "Size", Kind: "int64"},
+				{Name: "Settings", Kind: "struct", TypeName: "WidgetSettings", Fields: []apiField{
+					{Name: "Mode", Kind: "enum", TypeName: "WidgetMode"},
+				}},
+				{Name: "Labels", Kind: "list", Elem: &apiField{Kind: "string"}},
+				{Name: "Payload", Kind: "unsupported"},
+				{Name: "Tags", Kind: "map", Elem: &apiField{Kind: "string"}},
+			},
+		},
+		"GetWidget": {
+			Name: "GetWidget",
+			Output: []apiField{
+				{Name: "Widget", Kind: "struct", TypeName: "Widget", Fields: []apiField{
+					{Name: "CreatedAt", Kind: "timestamp"},
+					{Name: "Description", Kind: "string"},
+					{Name: "Id", Kind: "string"},
+					{Name: "WidgetStatus", Kind: "enum", TypeName: "WidgetStatus"},
+				}},
+			},
+		},
+		"UpdateWidget": {
+			Name: "UpdateWidget",
+			Input: []apiField{
+				{Name: "Description", Kind: "string"},
+				{Name: "Id", Kind: "string"},
+			},
+		},
+		"DeleteWidget": {
+			Name: "DeleteWidget",
+		},
+	}
+
+	m, err := newAPIModel("Widget", "widgets", ops, operations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := m.ReadMember, "Widget"; got != want {
+		t.Errorf("ReadMember: got %q, want %q", got, want)
+	}
+	if got, want := m.ReadResultType, "awstypes.Widget"; got != want {
+		t.Errorf("ReadResultType: got %q, want %q", got, want)
+	}
+	if got, want := m.StatusField, "WidgetStatus"; got != want {
+		t.Errorf("StatusField: got %q, want %q", got, want)
+	}
+	if got, want := m.StatusEnum, "WidgetStatus"; got != want {
+		t.Errorf("StatusEnum: got %q, want %q", got, want)
+	}
+	if got, want := strings.Join(m.Unsupported, ","), "Payload"; got != want {
+		t.Errorf("Unsupported: got %q, want %q", got, want)
+	}
+
+	fields := make(map[string]ModelField)
+	for _, v := range m.Model.Fields {
+		fields[v.TFName] = v
+	}
+
+	for _, v := range []string{"client_token", "id", "tags"} {
+		if _, ok := fields[v]; ok {
+			t.Errorf("unexpected field %q", v)
+		}
+	}
+
+	testCases := map[string]struct {
+		fieldName string
+		modelType string
+		block     bool
+		contains  []string
+		excludes  []string
+	}{
+		"created_at": {
+			fieldName: "CreatedAt",
+			modelType: "timetypes.RFC3339",
+			contains:  []string{"Computed: true", "timetypes.RFC3339Type{}"},
+		},
+		"description": {
+			fieldName: "Description",
+			modelType: "types.String",
+			contains:  []string{"Optional: true"},
+			excludes:  []string{"RequiresReplace"},
+		},
+		"kms_key_arn": {
+			fieldName: "KMSKeyARN",
+			modelType: "types.String",
+			contains:  []string{"Optional: true", "stringplanmodifier.RequiresReplace()"},
+		},
+		"labels": {
+			fieldName: "Labels",
+			modelType: "fwtypes.ListValueOf[types.String]",
+			contains:  []string{"fwtypes.ListOfStringType", "listplanmodifier.RequiresReplace()"},
+		},
+		"settings": {
+			fieldName: "Settings",
+			modelType: "fwtypes.ListNestedObjectValueOf[widgetSettingsModel]",
+			block:     true,
+			contains:  []string{"schema.ListNestedBlock{", "listvalidator.SizeAtMost(1)", "fwtypes.StringEnumType[awstypes.WidgetMode]()"},
+		},
+		"size": {
+			fieldName: "Size",
+			modelType: "types.Int64",
+			contains:  []string{"schema.Int64Attribute{", "int64planmodifier.RequiresReplace()"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		f, ok := fields[name]
+		if !ok {
+			t.Errorf("missing field %q", name)
+			continue
+		}
+
+		if got, want := f.FieldName, testCase.fieldName; got != want {
+			t.Errorf("%s FieldName: got %q, want %q", name, got, want)
+		}
+		if got, want := f.ModelType, testCase.modelType; got != want {
+			t.Errorf("%s ModelType: got %q, want %q", name, got, want)
+		}
+		if got, want := f.Block, testCase.block; got != want {
+			t.Errorf("%s Block: got %t, want %t", name, got, want)
+		}
+		for _, v := range testCase.contains {
+			if !strings.Contains(f.Schema, v) {
+				t.Errorf("%s Schema: expected to contain %q, got:\n%s", name, v, f.Schema)
+			}
+		}
+		for _, v := range testCase.excludes {
+			if strings.Contains(f.Schema, v) {
+				t.Errorf("%s Schema: expected not to contain %q, got:\n%s", name, v, f.Schema)
+			}
+		}
+	}
+
+	if got, want := len(m.Nested), 1; got != want {
+		t.Fatalf("Nested: got %d, want %d", got, want)
+	}
+	if got, want := m.Nested[0].Name, "widgetSettingsModel"; got != want {
+		t.Errorf("Nested[0].Name: got %q, want %q", got, want)
+	}
+}
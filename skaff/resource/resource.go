@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"golang.org/x/tools/imports"
 )
 
 //go:embed resource.tmpl
@@ -30,6 +31,9 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourceapi.tmpl
+var resourceAPITmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,6 +49,8 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	AWSGoSDKV2Package    string
+	APIModel             *APIModel
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
@@ -124,6 +130,135 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	return nil
 }
 
+// CreateFromAPI creates a Terraform Plugin Framework resource whose schema and model are derived,
+// by reflection, from the specified AWS SDK for Go v2 API operations' input and output structures.
+func CreateFromAPI(resName, snakeName, goV2Package string, ops Operations, comments, force, tags bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return fmt.Errorf("error checking: create, read and delete operations are required")
+	}
+
+	snakeName = convert.ToSnakeCase(resName, snakeName)
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting human-friendly name: %w", err)
+	}
+
+	if goV2Package == "" {
+		goV2Package, err = names.AWSGoV2Package(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting AWS SDK for Go v2 package: %w", err)
+		}
+	}
+
+	// The introspection program runs in the provider module, three levels up from the service package.
+	root := filepath.Join(wd, "..", "..", "..")
+	operations, err := introspect(root, goV2Package, ops)
+	if err != nil {
+		return err
+	}
+
+	apiModel, err := newAPIModel(resName, goV2Package, ops, operations)
+	if err != nil {
+		return err
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceLower:        strings.ToLower(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: hf,
+		IncludeComments:      comments,
+		IncludeTags:          tags,
+		ServicePackage:       servicePackage,
+		Service:              s,
+		ServiceLower:         strings.ToLower(s),
+		AWSServiceName:       sn,
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		AWSGoSDKV2Package:    goV2Package,
+		APIModel:             apiModel,
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeGoTemplate("newresapi", f, resourceAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// writeGoTemplate writes a Go source file from a template, removing unused imports and formatting the result.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := imports.Process(filename, buffer.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: false})
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
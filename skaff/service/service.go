@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed servicepackage.tmpl
+var servicePackageTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+type TemplateData struct {
+	Resource                string
+	ResourcePlural          string
+	HumanFriendlyService    string
+	HumanResourceName       string
+	HumanResourceNamePlural string
+	IncludeComments         bool
+	IncludeTags             bool
+	ListOps                 string
+	PluginFramework         bool
+	ProviderResourceName    string
+	Service                 string
+	ServicePackage          string
+	GoV2Package             string
+}
+
+// Create creates the skeleton of a new service package, including its first resource.
+// The service must already be defined in names/data/names_data.hcl.
+func Create(servicePackage, resName, snakeName, listOps string, comments, force, pluginFramework, tags, generate bool) error {
+	if servicePackage == "" {
+		return fmt.Errorf("error checking: no service package name given")
+	}
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no resource name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: resource name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	sr, err := findServiceRecord(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	root, err := findRepositoryRoot()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(root, "internal", "service", servicePackage)
+	if _, err := os.Stat(filepath.Join(dir, "generate.go")); err == nil && !force {
+		return fmt.Errorf("service package (%s) already exists and force is not set", dir)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating directory (%s): %s", dir, err)
+	}
+
+	snakeName = convert.ToSnakeCase(resName, snakeName)
+	templateData := TemplateData{
+		Resource:                resName,
+		ResourcePlural:          resName + "s",
+		HumanFriendlyService:    sr.HumanFriendly(),
+		HumanResourceName:       convert.ToHumanResName(resName),
+		HumanResourceNamePlural: convert.ToHumanResName(resName) + "s",
+		IncludeComments:         comments,
+		IncludeTags:             tags,
+		ListOps:                 listOps,
+		PluginFramework:         pluginFramework,
+		ProviderResourceName:    convert.ToProviderResourceName(servicePackage, snakeName),
+		Service:                 sr.ProviderNameUpper(),
+		ServicePackage:          servicePackage,
+		GoV2Package:             sr.GoV2Package(),
+	}
+
+	if err := writeTemplate("generate", filepath.Join(dir, "generate.go"), generateTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing generate template: %w", err)
+	}
+
+	// The service package's API client factory is generated unless the service needs a custom client.
+	if sr.SkipClientGenerate() {
+		if err := writeTemplate("servicepackage", filepath.Join(dir, "service_package.go"), servicePackageTmpl, force, templateData); err != nil {
+			return fmt.Errorf("writing service package template: %w", err)
+		}
+	}
+
+	if err := writeTemplate("sweep", filepath.Join(dir, "sweep.go"), sweepTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing sweep template: %w", err)
+	}
+
+	// The resource scaffolding is written relative to the service package directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		return fmt.Errorf("error changing directory (%s): %s", dir, err)
+	}
+
+	err = resource.Create(resName, snakeName, comments, force, true, pluginFramework, tags)
+
+	if err := os.Chdir(wd); err != nil {
+		return fmt.Errorf("error changing directory (%s): %s", wd, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !generate {
+		return nil
+	}
+
+	// Generate the service package, its endpoint resolver and tests, and register it with the provider and sweepers.
+	for _, v := range []string{
+		filepath.Join("internal", "service", servicePackage),
+		filepath.Join("internal", "generate", "serviceendpointtests"),
+		filepath.Join("internal", "provider"),
+		filepath.Join("internal", "sweep"),
+	} {
+		if err := goGenerate(root, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findServiceRecord(servicePackage string) (data.ServiceRecord, error) {
+	records, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, fmt.Errorf("error reading service data: %w", err)
+	}
+
+	for _, sr := range records {
+		if sr.ProviderPackage() != servicePackage {
+			continue
+		}
+
+		if sr.Exclude() {
+			return nil, fmt.Errorf("service (%s) is excluded in names/data/names_data.hcl", servicePackage)
+		}
+
+		if sr.NotImplemented() {
+			return nil, fmt.Errorf("service (%s) is marked not_implemented in names/data/names_data.hcl, remove the attribute first", servicePackage)
+		}
+
+		if !sr.ClientSDKV2() {
+			return nil, fmt.Errorf("service (%s) must use AWS SDK for Go v2 (client_version = [2] in names/data/names_data.hcl)", servicePackage)
+		}
+
+		return sr, nil
+	}
+
+	return nil, fmt.Errorf("service (%s) not found in names/data/names_data.hcl", servicePackage)
+}
+
+// findRepositoryRoot walks up from the working directory to the root of the provider repository.
+func findRepositoryRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "names", "data", "names_data.hcl")); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("error finding repository root: names/data/names_data.hcl not found, run skaff from within the provider repository")
+		}
+		dir = parent
+	}
+}
+
+func goGenerate(root, dir string) error {
+	cmd := exec.Command("go", "generate", "./"+filepath.ToSlash(dir))
+	cmd.Dir = root
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running go generate (%s): %w", dir, err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
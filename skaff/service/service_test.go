@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tmpl     string
+		td       TemplateData
+		contains []string
+	}{
+		"generate": {
+			tmpl: generateTmpl,
+			td: TemplateData{
+				ServicePackage: "examplesvc",
+			},
+			contains: []string{
+				"//go:generate go run ../../generate/servicepackage/main.go",
+				"package examplesvc",
+			},
+		},
+		"generate with tags and list ops": {
+			tmpl: generateTmpl,
+			td: TemplateData{
+				IncludeTags:    true,
+				ListOps:        "ListWidgets,ListGadgets",
+				ServicePackage: "examplesvc",
+			},
+			contains: []string{
+				"-ListOps=ListWidgets,ListGadgets",
+				"generate/tags/main.go",
+			},
+		},
+		"service package": {
+			tmpl: servicePackageTmpl,
+			td: TemplateData{
+				GoV2Package:     "examplesvc",
+				IncludeComments: true,
+				ServicePackage:  "examplesvc",
+			},
+			contains: []string{
+				"func (p *servicePackage) NewClient(",
+				"examplesvc.WithEndpointResolverV2(newEndpointResolverSDKv2())",
+			},
+		},
+		"sweep framework": {
+			tmpl: sweepTmpl,
+			td: TemplateData{
+				GoV2Package:          "examplesvc",
+				PluginFramework:      true,
+				ProviderResourceName: "aws_examplesvc_widget",
+				Resource:             "Widget",
+				ResourcePlural:       "Widgets",
+				Service:              "ExampleSvc",
+				ServicePackage:       "examplesvc",
+			},
+			contains: []string{
+				`resource.AddTestSweepers("aws_examplesvc_widget"`,
+				"framework.NewSweepResource(newResourceWidget, client,",
+			},
+		},
+		"sweep Plugin SDK": {
+			tmpl: sweepTmpl,
+			td: TemplateData{
+				GoV2Package:          "examplesvc",
+				ProviderResourceName: "aws_examplesvc_widget",
+				Resource:             "Widget",
+				ResourcePlural:       "Widgets",
+				Service:              "ExampleSvc",
+				ServicePackage:       "examplesvc",
+			},
+			contains: []string{
+				"r := ResourceWidget()",
+				"sweep.NewSweepResource(r, d, client)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "out.go")
+			if err := writeTemplate(name, filename, testCase.tmpl, false, testCase.td); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range testCase.contains {
+				if !strings.Contains(string(b), v) {
+					t.Errorf("expected output to contain %q, got:\n%s", v, b)
+				}
+			}
+		})
+	}
+}